@@ -0,0 +1,152 @@
+package harbor
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// artifactPageSize is the largest page size accepted by the Harbor artifacts API.
+const artifactPageSize = 100
+
+type Artifact struct {
+	ID                int64                           `json:"id"`
+	Type              string                          `json:"type"`
+	MediaType         string                          `json:"media_type"`
+	ManifestMediaType string                          `json:"manifest_media_type"`
+	ProjectID         int64                           `json:"project_id"`
+	RepositoryID      int64                           `json:"repository_id"`
+	Digest            string                          `json:"digest"`
+	Size              int64                           `json:"size"`
+	PushTime          time.Time                       `json:"push_time"`
+	PullTime          time.Time                       `json:"pull_time"`
+	Tags              []*Tag                          `json:"tags"`
+	Labels            []*Label                        `json:"labels"`
+	ScanOverview      map[string]*NativeReportSummary `json:"scan_overview"`
+}
+
+type ArtifactLabelReq struct {
+	ID int64 `json:"id"`
+}
+
+type ArtifactTagReq struct {
+	Name string `json:"name"`
+}
+
+type Tag struct {
+	ID           int64     `json:"id"`
+	RepositoryID int64     `json:"repository_id"`
+	ArtifactID   int64     `json:"artifact_id"`
+	Name         string    `json:"name"`
+	PushTime     time.Time `json:"push_time"`
+	PullTime     time.Time `json:"pull_time"`
+	Immutable    bool      `json:"immutable"`
+}
+
+type NativeReportSummary struct {
+	ReportID        string                `json:"report_id"`
+	ScanStatus      string                `json:"scan_status"`
+	Severity        string                `json:"severity"`
+	Duration        int64                 `json:"duration"`
+	Summary         *VulnerabilitySummary `json:"summary"`
+	StartTime       time.Time             `json:"start_time"`
+	EndTime         time.Time             `json:"end_time"`
+	CompletePercent int                   `json:"complete_percent"`
+	Scanner         *Scanner              `json:"scanner"`
+}
+
+type VulnerabilitySummary struct {
+	Total   int            `json:"total"`
+	Fixable int            `json:"fixable"`
+	Summary map[string]int `json:"summary"`
+}
+
+type Scanner struct {
+	Name    string `json:"name"`
+	Vendor  string `json:"vendor"`
+	Version string `json:"version"`
+}
+
+// GetArtifacts lists the artifacts in a repository, most recently pushed
+// first, including their tags and scan overview. When query is not empty it
+// is passed through as the Harbor 'q' parameter, e.g. 'tags=latest'.
+func (client *Client) GetArtifacts(projectName string, repoName string, query string) ([]*Artifact, error) {
+	var artifacts []*Artifact
+	path := fmt.Sprintf("%s/artifacts", RepositoryID(projectName, repoName))
+
+	for page := 1; ; page++ {
+		var artifactPage []*Artifact
+		params := map[string]string{
+			"page":               strconv.Itoa(page),
+			"page_size":          strconv.Itoa(artifactPageSize),
+			"sort":               "-push_time",
+			"with_tag":           "true",
+			"with_scan_overview": "true",
+		}
+		if query != "" {
+			params["q"] = query
+		}
+
+		err := client.get(APIURLVersion2, path, &artifactPage, params)
+		if err != nil {
+			return nil, err
+		}
+
+		artifacts = append(artifacts, artifactPage...)
+		if len(artifactPage) < artifactPageSize {
+			break
+		}
+	}
+
+	return artifacts, nil
+}
+
+// GetArtifact gets a single artifact by digest or tag, including its tags,
+// labels and scan overview.
+func (client *Client) GetArtifact(projectName string, repoName string, reference string) (*Artifact, error) {
+	var artifact *Artifact
+	params := map[string]string{
+		"with_tag":           "true",
+		"with_label":         "true",
+		"with_scan_overview": "true",
+	}
+
+	err := client.get(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s", RepositoryID(projectName, repoName), reference), &artifact, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return artifact, nil
+}
+
+func (client *Client) AddArtifactLabel(projectName string, repoName string, reference string, labelID int64) error {
+	_, _, err := client.post(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s/labels", RepositoryID(projectName, repoName), reference), &ArtifactLabelReq{ID: labelID})
+	return err
+}
+
+func (client *Client) RemoveArtifactLabel(projectName string, repoName string, reference string, labelID int64) error {
+	return client.delete(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s/labels/%d", RepositoryID(projectName, repoName), reference, labelID), nil)
+}
+
+func (client *Client) AddArtifactTag(projectName string, repoName string, reference string, tagName string) error {
+	_, _, err := client.post(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s/tags", RepositoryID(projectName, repoName), reference), &ArtifactTagReq{Name: tagName})
+	return err
+}
+
+func (client *Client) DeleteArtifactTag(projectName string, repoName string, reference string, tagName string) error {
+	return client.delete(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s/tags/%s", RepositoryID(projectName, repoName), reference, tagName), nil)
+}
+
+func (client *Client) DeleteArtifact(projectName string, repoName string, reference string) error {
+	return client.delete(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s", RepositoryID(projectName, repoName), reference), nil)
+}
+
+func (client *Client) DeleteArtifacts(projectName string, repoName string, artifacts []*Artifact) error {
+	for _, artifact := range artifacts {
+		err := client.DeleteArtifact(projectName, repoName, artifact.Digest)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+	}
+	return nil
+}
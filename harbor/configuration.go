@@ -0,0 +1,26 @@
+package harbor
+
+type ConfigurationValue struct {
+	Value    interface{} `json:"value"`
+	Editable bool        `json:"editable"`
+}
+
+// GetConfigurations returns the system configuration, keyed by Harbor's
+// configuration item names such as 'auth_mode'. Password items are never
+// returned by Harbor.
+func (client *Client) GetConfigurations() (map[string]*ConfigurationValue, error) {
+	configurations := make(map[string]*ConfigurationValue)
+
+	err := client.get(APIURLVersion2, "/configurations", &configurations, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return configurations, nil
+}
+
+// UpdateConfigurations sets the given configuration items, leaving any
+// items not present in the map unchanged.
+func (client *Client) UpdateConfigurations(configurations map[string]interface{}) error {
+	return client.put(APIURLVersion2, "/configurations", configurations)
+}
@@ -0,0 +1,29 @@
+package harbor
+
+type CVEAllowlist struct {
+	ID           int64               `json:"id,omitempty"`
+	ProjectID    int64               `json:"project_id,omitempty"`
+	ExpiresAt    *int64              `json:"expires_at"`
+	Items        []*CVEAllowlistItem `json:"items"`
+	CreationTime string              `json:"creation_time,omitempty"`
+	UpdateTime   string              `json:"update_time,omitempty"`
+}
+
+type CVEAllowlistItem struct {
+	CVEID string `json:"cve_id"`
+}
+
+func (client *Client) GetSystemCVEAllowlist() (*CVEAllowlist, error) {
+	var allowlist *CVEAllowlist
+
+	err := client.get(APIURLVersion2, "/system/CVEAllowlist", &allowlist, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return allowlist, nil
+}
+
+func (client *Client) UpdateSystemCVEAllowlist(allowlist *CVEAllowlist) error {
+	return client.put(APIURLVersion2, "/system/CVEAllowlist", allowlist)
+}
@@ -20,3 +20,9 @@ func ErrorIs404(err error) bool {
 
 	return ok && harborError != nil && harborError.Code == http.StatusNotFound
 }
+
+func ErrorIs403(err error) bool {
+	harborError, ok := errwrap.GetType(err, &APIError{}).(*APIError)
+
+	return ok && harborError != nil && harborError.Code == http.StatusForbidden
+}
@@ -0,0 +1,34 @@
+package harbor
+
+import (
+	"strconv"
+)
+
+type GCHistory struct {
+	ID            int64        `json:"id"`
+	JobName       string       `json:"job_name"`
+	JobKind       string       `json:"job_kind"`
+	JobParameters string       `json:"job_parameters"`
+	Schedule      *ScheduleObj `json:"schedule"`
+	JobStatus     string       `json:"job_status"`
+	Deleted       bool         `json:"deleted"`
+	CreationTime  string       `json:"creation_time"`
+	UpdateTime    string       `json:"update_time"`
+}
+
+// GetGCHistory lists up to limit garbage collection runs, most recent first.
+func (client *Client) GetGCHistory(limit int) ([]*GCHistory, error) {
+	var history []*GCHistory
+	params := map[string]string{
+		"page":      "1",
+		"page_size": strconv.Itoa(limit),
+		"sort":      "-creation_time",
+	}
+
+	err := client.get(APIURLVersion2, "/system/gc", &history, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
@@ -129,6 +129,19 @@ func (client *Client) get(apiURL string, path string, resource interface{}, para
 	return json.Unmarshal(body, resource)
 }
 
+// getRaw gets a document from the OCI distribution API of Harbor, which
+// serves manifests and blobs rather than Harbor API resources.
+func (client *Client) getRaw(path string, accept string) ([]byte, error) {
+	request, err := http.NewRequest(http.MethodGet, client.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Accept", accept)
+
+	body, _, err := client.sendRequest(request)
+	return body, err
+}
+
 func (client *Client) post(apiURL string, path string, requestBody interface{}) ([]byte, string, error) {
 	resourceURL := client.baseURL + apiURL + path
 
@@ -166,6 +179,24 @@ func (client *Client) put(apiURL string, path string, requestBody interface{}) e
 	return err
 }
 
+func (client *Client) patch(apiURL string, path string, requestBody interface{}) error {
+	resourceURL := client.baseURL + apiURL + path
+
+	payload, err := json.Marshal(requestBody)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPatch, resourceURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.sendRequest(request)
+
+	return err
+}
+
 func (client *Client) delete(apiURL string, path string, requestBody interface{}) error {
 	resourceURL := client.baseURL + apiURL + path
 
@@ -1,5 +1,12 @@
 package harbor
 
+import (
+	"strconv"
+)
+
+// labelPageSize is the largest page size accepted by the Harbor labels API.
+const labelPageSize = 100
+
 type Label struct {
 	CreationTime string `json:"creation_time,omitempty"`
 	UpdateTime   string `json:"update_time,omitempty"`
@@ -24,6 +31,40 @@ func (client *Client) GetLabel(id string) (*Label, error) {
 	return label, nil
 }
 
+// ListLabels lists labels in the given scope, 'g' for global labels or 'p'
+// for labels of the project with projectID. Harbor matches name as a
+// substring, so callers wanting an exact match must filter the result.
+func (client *Client) ListLabels(scope string, projectID int64, name string) ([]*Label, error) {
+	var labels []*Label
+
+	for page := 1; ; page++ {
+		var labelPage []*Label
+		params := map[string]string{
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(labelPageSize),
+			"scope":     scope,
+		}
+		if scope == "p" {
+			params["project_id"] = strconv.FormatInt(projectID, 10)
+		}
+		if name != "" {
+			params["name"] = name
+		}
+
+		err := client.get(APIURLVersion2, "/labels", &labelPage, params)
+		if err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, labelPage...)
+		if len(labelPage) < labelPageSize {
+			break
+		}
+	}
+
+	return labels, nil
+}
+
 func (client *Client) NewLabel(label *Label) (string, error) {
 	_, location, err := client.post(APIURLVersion2, "/labels", label)
 	return location, err
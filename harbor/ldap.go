@@ -0,0 +1,45 @@
+package harbor
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type LdapConf struct {
+	LdapURL               string `json:"ldap_url"`
+	LdapSearchDN          string `json:"ldap_search_dn,omitempty"`
+	LdapSearchPassword    string `json:"ldap_search_password,omitempty"`
+	LdapBaseDN            string `json:"ldap_base_dn,omitempty"`
+	LdapFilter            string `json:"ldap_filter,omitempty"`
+	LdapUID               string `json:"ldap_uid,omitempty"`
+	LdapScope             int    `json:"ldap_scope"`
+	LdapConnectionTimeout int    `json:"ldap_connection_timeout,omitempty"`
+	LdapVerifyCert        bool   `json:"ldap_verify_cert"`
+}
+
+type LdapPingResult struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// PingLdap asks Harbor to bind to the LDAP server with the given settings. An
+// empty search password makes Harbor fall back to the stored one.
+func (client *Client) PingLdap(conf *LdapConf) error {
+	body, _, err := client.post(APIURLVersion2, "/ldap/ping", conf)
+	if err != nil {
+		return err
+	}
+
+	var result *LdapPingResult
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+
+	if !result.Success {
+		return fmt.Errorf("failed to connect to LDAP server %s: %s", conf.LdapURL, result.Message)
+	}
+
+	return nil
+}
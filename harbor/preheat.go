@@ -0,0 +1,106 @@
+package harbor
+
+import (
+	"fmt"
+)
+
+// PreheatInstance is a P2P provider, such as Dragonfly or Kraken, that artifacts are preheated into.
+type PreheatInstance struct {
+	ID             int64             `json:"id,omitempty"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	Vendor         string            `json:"vendor"`
+	Endpoint       string            `json:"endpoint"`
+	AuthMode       string            `json:"auth_mode"`
+	AuthInfo       map[string]string `json:"auth_info,omitempty"`
+	Status         string            `json:"status,omitempty"`
+	Enabled        bool              `json:"enabled"`
+	Default        bool              `json:"default"`
+	Insecure       bool              `json:"insecure"`
+	SetupTimestamp int64             `json:"setup_timestamp,omitempty"`
+}
+
+// PreheatPolicy is a preheat policy of a project. Filters and Trigger are
+// JSON documents encoded as strings, as Harbor expects them.
+type PreheatPolicy struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProjectID   int64  `json:"project_id"`
+	ProviderID  int64  `json:"provider_id"`
+	Filters     string `json:"filters"`
+	Trigger     string `json:"trigger"`
+	Enabled     bool   `json:"enabled"`
+}
+
+type PreheatFilter struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type PreheatTrigger struct {
+	Type           string                 `json:"type"`
+	TriggerSetting *PreheatTriggerSetting `json:"trigger_setting,omitempty"`
+}
+
+type PreheatTriggerSetting struct {
+	Cron string `json:"cron,omitempty"`
+}
+
+// PreheatInstanceID returns the API path of a preheat instance, which is used as its resource ID.
+func PreheatInstanceID(name string) string {
+	return fmt.Sprintf("/p2p/preheat/instances/%s", name)
+}
+
+// PreheatPolicyID returns the API path of a preheat policy, which is used as its resource ID.
+func PreheatPolicyID(projectName string, name string) string {
+	return fmt.Sprintf("/projects/%s/preheat/policies/%s", projectName, name)
+}
+
+func (client *Client) GetPreheatInstance(id string) (*PreheatInstance, error) {
+	var instance *PreheatInstance
+
+	err := client.get(APIURLVersion2, id, &instance, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+func (client *Client) NewPreheatInstance(instance *PreheatInstance) error {
+	_, _, err := client.post(APIURLVersion2, "/p2p/preheat/instances", instance)
+	return err
+}
+
+func (client *Client) UpdatePreheatInstance(id string, instance *PreheatInstance) error {
+	return client.put(APIURLVersion2, id, instance)
+}
+
+func (client *Client) DeletePreheatInstance(id string) error {
+	return client.delete(APIURLVersion2, id, nil)
+}
+
+func (client *Client) GetPreheatPolicy(id string) (*PreheatPolicy, error) {
+	var policy *PreheatPolicy
+
+	err := client.get(APIURLVersion2, id, &policy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (client *Client) NewPreheatPolicy(projectName string, policy *PreheatPolicy) error {
+	_, _, err := client.post(APIURLVersion2, fmt.Sprintf("/projects/%s/preheat/policies", projectName), policy)
+	return err
+}
+
+func (client *Client) UpdatePreheatPolicy(id string, policy *PreheatPolicy) error {
+	return client.put(APIURLVersion2, id, policy)
+}
+
+func (client *Client) DeletePreheatPolicy(id string) error {
+	return client.delete(APIURLVersion2, id, nil)
+}
@@ -1,36 +1,49 @@
 package harbor
 
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// projectPageSize is the largest page size accepted by the Harbor projects API.
+const projectPageSize = 100
+
 type ProjectReq struct {
-	CountLimit  int64  `json:"count_limit,omitempty"`
-	ProjectName string `json:"project_name,omitempty"`
-	// CVEWhitelist `json:"cve_whitelist"`
+	CountLimit   int64           `json:"count_limit,omitempty"`
+	ProjectName  string          `json:"project_name,omitempty"`
+	CVEAllowlist *CVEAllowlist   `json:"cve_allowlist,omitempty"`
 	StorageLimit int64           `json:"storage_limit,omitempty"`
+	RegistryID   *int64          `json:"registry_id,omitempty"`
 	Metadata     ProjectMetadata `json:"metadata,omitempty"`
 }
 
 type Project struct {
-	UpdateTime         string  `json:"update_time"`
-	OwnerName          string  `json:"owner_name"`
-	Name               string  `json:"name"`
-	Deleted            bool    `json:"deleted"`
-	OwnerID            int32   `json:"owner_id"`
-	RepoCount          int     `json:"repo_count"`
-	CreationTime       string  `json:"creation_time"`
-	Togglable          bool    `json:"togglable"`
-	ProjectID          int32   `json:"project_id"`
-	CurrentUserRoleIDs []int32 `json:"current_user_role_ids"`
-	ChartCount         int     `json:"chart_count"`
-	// CVEWhitelist `json:"cve_whitelist"`
-	Metadata ProjectMetadata `json:"metadata"`
+	UpdateTime         string          `json:"update_time"`
+	OwnerName          string          `json:"owner_name"`
+	Name               string          `json:"name"`
+	Deleted            bool            `json:"deleted"`
+	OwnerID            int32           `json:"owner_id"`
+	RepoCount          int             `json:"repo_count"`
+	CreationTime       string          `json:"creation_time"`
+	Togglable          bool            `json:"togglable"`
+	ProjectID          int32           `json:"project_id"`
+	CurrentUserRoleIDs []int32         `json:"current_user_role_ids"`
+	ChartCount         int             `json:"chart_count"`
+	CVEAllowlist       *CVEAllowlist   `json:"cve_allowlist"`
+	RegistryID         int64           `json:"registry_id"`
+	Metadata           ProjectMetadata `json:"metadata"`
 }
 
 type ProjectMetadata struct {
-	EnableContentTrust   string `json:"enable_content_trust,omitempty"`
-	AutoScan             bool   `json:"auto_scan,string"`
-	Severity             string `json:"severity,omitempty"`
-	ReuseSysCveWhitelist string `json:"reuse_sys_cve_whitelist,omitempty"`
-	Public               bool   `json:"public,string"`
-	PreventVul           string `json:"prevent_vul,omitempty"`
+	EnableContentTrust       bool   `json:"enable_content_trust,string"`
+	EnableContentTrustCosign bool   `json:"enable_content_trust_cosign,string"`
+	AutoScan                 bool   `json:"auto_scan,string"`
+	Severity                 string `json:"severity,omitempty"`
+	ReuseSysCveWhitelist     string `json:"reuse_sys_cve_whitelist,omitempty"`
+	Public                   bool   `json:"public,string"`
+	PreventVul               string `json:"prevent_vul,omitempty"`
+	ProxySpeedKB             string `json:"proxy_speed_kb,omitempty"`
 }
 
 func (client *Client) GetProject(id string) (*Project, error) {
@@ -44,6 +57,63 @@ func (client *Client) GetProject(id string) (*Project, error) {
 	return project, nil
 }
 
+// GetProjectByName looks up a project by its exact name. Harbor matches
+// every project whose name contains the filter, so all of them are listed.
+func (client *Client) GetProjectByName(name string) (*Project, error) {
+	projects, err := client.GetProjects(name, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range projects {
+		if project.Name == name {
+			return project, nil
+		}
+	}
+
+	return nil, &APIError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("project %s not found", name),
+	}
+}
+
+// GetProjects lists the projects visible to the user. Each filter is only
+// applied when set: name matches projects whose name contains it, public
+// matches the visibility of projects and owner the username of their owner.
+func (client *Client) GetProjects(name string, public *bool, owner string) ([]*Project, error) {
+	var projects []*Project
+
+	for page := 1; ; page++ {
+		var projectPage []*Project
+		params := map[string]string{
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(projectPageSize),
+			"sort":      "name",
+		}
+		if name != "" {
+			params["name"] = name
+		}
+		if public != nil {
+			params["public"] = strconv.FormatBool(*public)
+		}
+		if owner != "" {
+			params["owner"] = owner
+		}
+
+		err := client.get(APIURLVersion2, "/projects", &projectPage, params)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, projectPage...)
+		if len(projectPage) < projectPageSize {
+			break
+		}
+	}
+
+	return projects, nil
+}
+
 func (client *Client) NewProject(project *ProjectReq) (string, error) {
 	_, location, err := client.post(APIURLVersion2, "/projects", project)
 	return location, err
@@ -0,0 +1,95 @@
+package harbor
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// quotaPageSize is the largest page size accepted by the Harbor quotas API.
+const quotaPageSize = 100
+
+type Quota struct {
+	ID           int64            `json:"id"`
+	Ref          *QuotaRefObject  `json:"ref"`
+	Hard         map[string]int64 `json:"hard"`
+	Used         map[string]int64 `json:"used"`
+	CreationTime string           `json:"creation_time"`
+	UpdateTime   string           `json:"update_time"`
+}
+
+type QuotaRefObject struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	OwnerName string `json:"owner_name"`
+}
+
+type QuotaUpdateReq struct {
+	Hard map[string]int64 `json:"hard"`
+}
+
+func (client *Client) GetQuota(id string) (*Quota, error) {
+	var quota *Quota
+
+	err := client.get(APIURLVersion2, id, &quota, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return quota, nil
+}
+
+// GetProjectQuota gets the quota of the project with the given
+// '/projects/${ID_NUMBER}' ID.
+func (client *Client) GetProjectQuota(projectID string) (*Quota, error) {
+	var quotas []*Quota
+	params := map[string]string{
+		"reference":    "project",
+		"reference_id": strings.TrimPrefix(projectID, "/projects/"),
+	}
+
+	err := client.get(APIURLVersion2, "/quotas", &quotas, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quotas) == 0 {
+		return nil, &APIError{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("quota of project %s not found", projectID),
+		}
+	}
+
+	return quotas[0], nil
+}
+
+// GetProjectQuotas lists the quotas of every project.
+func (client *Client) GetProjectQuotas() ([]*Quota, error) {
+	var quotas []*Quota
+
+	for page := 1; ; page++ {
+		var quotaPage []*Quota
+		params := map[string]string{
+			"reference": "project",
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(quotaPageSize),
+		}
+
+		err := client.get(APIURLVersion2, "/quotas", &quotaPage, params)
+		if err != nil {
+			return nil, err
+		}
+
+		quotas = append(quotas, quotaPage...)
+		if len(quotaPage) < quotaPageSize {
+			break
+		}
+	}
+
+	return quotas, nil
+}
+
+func (client *Client) UpdateQuota(id string, quota *QuotaUpdateReq) error {
+	return client.put(APIURLVersion2, id, quota)
+}
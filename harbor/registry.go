@@ -0,0 +1,52 @@
+package harbor
+
+import (
+	"fmt"
+)
+
+// proxyCacheRegistryTypes are the registry types Harbor can use as the
+// upstream of a proxy cache project.
+var proxyCacheRegistryTypes = []string{
+	"docker-hub",
+	"docker-registry",
+	"harbor",
+	"aws-ecr",
+	"azure-acr",
+	"google-gcr",
+	"quay",
+	"github-ghcr",
+	"jfrog-artifactory",
+}
+
+type Registry struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Type         string `json:"type"`
+	URL          string `json:"url"`
+	Insecure     bool   `json:"insecure"`
+	Status       string `json:"status"`
+	CreationTime string `json:"creation_time"`
+	UpdateTime   string `json:"update_time"`
+}
+
+// SupportsProxyCache reports whether the registry can be the upstream of a proxy cache project.
+func (registry *Registry) SupportsProxyCache() bool {
+	for _, registryType := range proxyCacheRegistryTypes {
+		if registry.Type == registryType {
+			return true
+		}
+	}
+	return false
+}
+
+func (client *Client) GetRegistry(id int64) (*Registry, error) {
+	var registry *Registry
+
+	err := client.get(APIURLVersion2, fmt.Sprintf("/registries/%d", id), &registry, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
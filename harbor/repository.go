@@ -2,45 +2,113 @@ package harbor
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// repositoryPageSize is the largest page size accepted by the Harbor repositories API.
+const repositoryPageSize = 100
+
 type Repository struct {
-	ID           int           `json:"id"`
-	Name         string        `json:"name"`
-	ProjectID    int           `json:"project_id"`
-	Description  string        `json:"description"`
-	PullCount    int           `json:"pull_count"`
-	StarCount    int           `json:"star_count"`
-	TagsCount    int           `json:"tags_count"`
-	Labels       []interface{} `json:"labels"`
-	CreationTime time.Time     `json:"creation_time"`
-	UpdateTime   time.Time     `json:"update_time"`
-}
-
-func (client *Client) GetRepositories(projectName string) ([]*Repository, error) {
-	var repositories []*Repository
-	err := client.get(APIURLVersion2, fmt.Sprintf("/projects/%s/repositories", projectName), &repositories, nil)
+	ID            int           `json:"id"`
+	Name          string        `json:"name"`
+	ProjectID     int           `json:"project_id"`
+	Description   string        `json:"description"`
+	PullCount     int           `json:"pull_count"`
+	StarCount     int           `json:"star_count"`
+	TagsCount     int           `json:"tags_count"`
+	ArtifactCount int           `json:"artifact_count"`
+	Labels        []interface{} `json:"labels"`
+	CreationTime  time.Time     `json:"creation_time"`
+	UpdateTime    time.Time     `json:"update_time"`
+}
+
+type RepositoryReq struct {
+	Description string `json:"description"`
+}
+
+// escapeRepositoryName strips the project prefix from a repository name and
+// double-encodes it, as Harbor requires for repository names containing slashes.
+func escapeRepositoryName(projectName string, repoName string) string {
+	repo := strings.TrimPrefix(repoName, projectName+"/")
+
+	return url.PathEscape(url.PathEscape(repo))
+}
+
+// RepositoryID returns the API path of a repository, which is used as its resource ID.
+func RepositoryID(projectName string, repoName string) string {
+	return fmt.Sprintf("/projects/%s/repositories/%s", projectName, escapeRepositoryName(projectName, repoName))
+}
+
+func (client *Client) GetRepository(projectName string, repoName string) (*Repository, error) {
+	var repository *Repository
+
+	err := client.get(APIURLVersion2, RepositoryID(projectName, repoName), &repository, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return repository, nil
+}
+
+func (client *Client) UpdateRepository(projectName string, repoName string, repository *RepositoryReq) error {
+	return client.put(APIURLVersion2, RepositoryID(projectName, repoName), repository)
+}
+
+// GetRepositories lists the repositories in a project. When query is not
+// empty it is passed through as the Harbor 'q' parameter, e.g. 'name=~app'.
+func (client *Client) GetRepositories(projectName string, query string) ([]*Repository, error) {
+	var repositories []*Repository
+
+	for page := 1; ; page++ {
+		var repositoryPage []*Repository
+		params := map[string]string{
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(repositoryPageSize),
+		}
+		if query != "" {
+			params["q"] = query
+		}
+
+		err := client.get(APIURLVersion2, fmt.Sprintf("/projects/%s/repositories", projectName), &repositoryPage, params)
+		if err != nil {
+			return nil, err
+		}
+
+		repositories = append(repositories, repositoryPage...)
+		if len(repositoryPage) < repositoryPageSize {
+			break
+		}
+	}
+
 	return repositories, nil
 }
 
 func (client *Client) DeleteRepository(projectName string, repoName string) error {
-	repo := strings.TrimPrefix(repoName, projectName+"/")
-
-	return client.delete(APIURLVersion2, fmt.Sprintf("/projects/%s/repositories/%s", projectName, repo), nil)
+	return client.delete(APIURLVersion2, RepositoryID(projectName, repoName), nil)
 }
 
+// DeleteRepositories removes every artifact in each repository, including
+// non-image artifacts such as Helm charts and CNAB bundles, before removing
+// the repository itself.
 func (client *Client) DeleteRepositories(projectName string, repos []*Repository) error {
 	for _, repo := range repos {
-		err := client.DeleteRepository(projectName, repo.Name)
+		artifacts, err := client.GetArtifacts(projectName, repo.Name, "")
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+
+		err = client.DeleteArtifacts(projectName, repo.Name, artifacts)
 		if err != nil {
 			return err
 		}
+
+		err = client.DeleteRepository(projectName, repo.Name)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
 	}
 	return nil
 }
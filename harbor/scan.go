@@ -0,0 +1,78 @@
+package harbor
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MimeTypeNativeReport is the media type of the native vulnerability reports of Harbor.
+	MimeTypeNativeReport = "application/vnd.security.vulnerability.report; version=1.1"
+	// MimeTypeNativeReportLegacy is the media type of native vulnerability reports produced by older scanners.
+	MimeTypeNativeReportLegacy = "application/vnd.scanner.adapter.vuln.report.harbor+json; version=1.0"
+)
+
+type ScanAllMetrics struct {
+	Total     int            `json:"total"`
+	Completed int            `json:"completed"`
+	Metrics   map[string]int `json:"metrics"`
+	Ongoing   bool           `json:"ongoing"`
+	Trigger   string         `json:"trigger"`
+}
+
+// VulnerabilityReport is the vulnerability report of an artifact produced by a scanner.
+type VulnerabilityReport struct {
+	GeneratedAt     time.Time        `json:"generated_at"`
+	Scanner         *Scanner         `json:"scanner"`
+	Severity        string           `json:"severity"`
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities"`
+}
+
+type Vulnerability struct {
+	ID            string   `json:"id"`
+	Package       string   `json:"package"`
+	Version       string   `json:"version"`
+	FixVersion    string   `json:"fix_version"`
+	Severity      string   `json:"severity"`
+	Description   string   `json:"description"`
+	Links         []string `json:"links"`
+	PreferredCVSS *CVSS    `json:"preferred_cvss"`
+}
+
+type CVSS struct {
+	ScoreV3  *float64 `json:"score_v3"`
+	ScoreV2  *float64 `json:"score_v2"`
+	VectorV3 string   `json:"vector_v3"`
+	VectorV2 string   `json:"vector_v2"`
+}
+
+func (client *Client) GetScanAllMetrics() (*ScanAllMetrics, error) {
+	var metrics *ScanAllMetrics
+
+	err := client.get(APIURLVersion2, "/scans/all/metrics", &metrics, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// GetArtifactVulnerabilities gets the vulnerability reports of an artifact,
+// keyed by the media type of the report.
+func (client *Client) GetArtifactVulnerabilities(projectName string, repoName string, reference string) (map[string]*VulnerabilityReport, error) {
+	var reports map[string]*VulnerabilityReport
+
+	err := client.get(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s/additions/vulnerabilities", RepositoryID(projectName, repoName), reference), &reports, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// ScanArtifact requests a vulnerability scan of an artifact. The scan runs
+// asynchronously, its progress is reported by the scan overview of the artifact.
+func (client *Client) ScanArtifact(projectName string, repoName string, reference string) error {
+	_, _, err := client.post(APIURLVersion2, fmt.Sprintf("%s/artifacts/%s/scan", RepositoryID(projectName, repoName), reference), map[string]string{})
+	return err
+}
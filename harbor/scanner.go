@@ -0,0 +1,120 @@
+package harbor
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// scannerPageSize is the largest page size accepted by the Harbor scanners API.
+const scannerPageSize = 100
+
+type ScannerRegistration struct {
+	UUID             string `json:"uuid,omitempty"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	URL              string `json:"url"`
+	Disabled         bool   `json:"disabled"`
+	IsDefault        bool   `json:"is_default,omitempty"`
+	Auth             string `json:"auth"`
+	AccessCredential string `json:"access_credential,omitempty"`
+	SkipCertVerify   bool   `json:"skip_certVerify"`
+	UseInternalAddr  bool   `json:"use_internal_addr"`
+	CreateTime       string `json:"create_time,omitempty"`
+	UpdateTime       string `json:"update_time,omitempty"`
+}
+
+type ScannerAdapterMetadata struct {
+	Scanner      *Scanner          `json:"scanner"`
+	Capabilities []interface{}     `json:"capabilities"`
+	Properties   map[string]string `json:"properties"`
+}
+
+type ScannerDefaultReq struct {
+	IsDefault bool `json:"is_default"`
+}
+
+type ProjectScannerReq struct {
+	UUID string `json:"uuid"`
+}
+
+func (client *Client) GetScanner(id string) (*ScannerRegistration, error) {
+	var scanner *ScannerRegistration
+
+	err := client.get(APIURLVersion2, id, &scanner, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanner, nil
+}
+
+func (client *Client) GetScanners() ([]*ScannerRegistration, error) {
+	var scanners []*ScannerRegistration
+
+	for page := 1; ; page++ {
+		var scannerPage []*ScannerRegistration
+		params := map[string]string{
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(scannerPageSize),
+		}
+
+		err := client.get(APIURLVersion2, "/scanners", &scannerPage, params)
+		if err != nil {
+			return nil, err
+		}
+
+		scanners = append(scanners, scannerPage...)
+		if len(scannerPage) < scannerPageSize {
+			break
+		}
+	}
+
+	return scanners, nil
+}
+
+// GetScannerMetadata asks the scanner adapter for its metadata, which
+// includes properties such as when its vulnerability database was updated.
+func (client *Client) GetScannerMetadata(id string) (*ScannerAdapterMetadata, error) {
+	var metadata *ScannerAdapterMetadata
+
+	err := client.get(APIURLVersion2, fmt.Sprintf("%s/metadata", id), &metadata, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+func (client *Client) NewScanner(scanner *ScannerRegistration) (string, error) {
+	_, location, err := client.post(APIURLVersion2, "/scanners", scanner)
+	return location, err
+}
+
+func (client *Client) UpdateScanner(id string, scanner *ScannerRegistration) error {
+	return client.put(APIURLVersion2, id, scanner)
+}
+
+// SetDefaultScanner makes the scanner the system default. Harbor doesn't
+// allow unsetting the default, another scanner must be made the default instead.
+func (client *Client) SetDefaultScanner(id string) error {
+	return client.patch(APIURLVersion2, id, &ScannerDefaultReq{IsDefault: true})
+}
+
+func (client *Client) DeleteScanner(id string) error {
+	return client.delete(APIURLVersion2, id, nil)
+}
+
+func (client *Client) GetProjectScanner(projectID string) (*ScannerRegistration, error) {
+	var scanner *ScannerRegistration
+
+	err := client.get(APIURLVersion2, fmt.Sprintf("%s/scanner", projectID), &scanner, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanner, nil
+}
+
+func (client *Client) SetProjectScanner(projectID string, scannerUUID string) error {
+	return client.put(APIURLVersion2, fmt.Sprintf("%s/scanner", projectID), &ProjectScannerReq{UUID: scannerUUID})
+}
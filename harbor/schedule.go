@@ -0,0 +1,106 @@
+package harbor
+
+const (
+	ScheduleTypeNone   = "None"
+	ScheduleTypeHourly = "Hourly"
+	ScheduleTypeDaily  = "Daily"
+	ScheduleTypeWeekly = "Weekly"
+	ScheduleTypeCustom = "Custom"
+)
+
+// Schedule is the body shared by Harbor's system job schedules, such as
+// scan all, garbage collection and audit log purge.
+type Schedule struct {
+	ID           int64                  `json:"id,omitempty"`
+	Status       string                 `json:"status,omitempty"`
+	CreationTime string                 `json:"creation_time,omitempty"`
+	UpdateTime   string                 `json:"update_time,omitempty"`
+	Schedule     *ScheduleObj           `json:"schedule"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+}
+
+type ScheduleObj struct {
+	Type              string `json:"type"`
+	Cron              string `json:"cron,omitempty"`
+	NextScheduledTime string `json:"next_scheduled_time,omitempty"`
+}
+
+func (client *Client) getSchedule(path string) (*Schedule, error) {
+	var schedule *Schedule
+
+	err := client.get(APIURLVersion2, path, &schedule, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// setSchedule creates the schedule at path, or updates it if it already exists.
+func (client *Client) setSchedule(path string, schedule *Schedule) error {
+	current, err := client.getSchedule(path)
+	if err != nil {
+		return err
+	}
+
+	if current == nil || current.Schedule == nil {
+		_, _, err = client.post(APIURLVersion2, path, schedule)
+		return err
+	}
+
+	return client.put(APIURLVersion2, path, schedule)
+}
+
+func (client *Client) GetScanAllSchedule() (*Schedule, error) {
+	return client.getSchedule("/system/scanAll/schedule")
+}
+
+func (client *Client) SetScanAllSchedule(schedule *Schedule) error {
+	return client.setSchedule("/system/scanAll/schedule", schedule)
+}
+
+// GetGCSchedule gets the garbage collection schedule. Harbor returns it as a
+// garbage collection run, whose parameters are a JSON encoded string.
+func (client *Client) GetGCSchedule() (*GCHistory, error) {
+	var schedule *GCHistory
+
+	err := client.get(APIURLVersion2, "/system/gc/schedule", &schedule, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (client *Client) SetGCSchedule(schedule *Schedule) error {
+	return client.setSchedule("/system/gc/schedule", schedule)
+}
+
+// ExecHistory is a run of a system job, such as an audit log purge.
+type ExecHistory struct {
+	ID            int64        `json:"id"`
+	JobName       string       `json:"job_name"`
+	JobKind       string       `json:"job_kind"`
+	JobParameters string       `json:"job_parameters"`
+	Schedule      *ScheduleObj `json:"schedule"`
+	JobStatus     string       `json:"job_status"`
+	CreationTime  string       `json:"creation_time"`
+	UpdateTime    string       `json:"update_time"`
+}
+
+// GetPurgeAuditSchedule gets the audit log purge schedule. Harbor returns it
+// as a purge run, whose parameters are a JSON encoded string.
+func (client *Client) GetPurgeAuditSchedule() (*ExecHistory, error) {
+	var schedule *ExecHistory
+
+	err := client.get(APIURLVersion2, "/system/purgeaudit/schedule", &schedule, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (client *Client) SetPurgeAuditSchedule(schedule *Schedule) error {
+	return client.setSchedule("/system/purgeaudit/schedule", schedule)
+}
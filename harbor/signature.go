@@ -0,0 +1,165 @@
+package harbor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// ArtifactTypeNotationSignature is the OCI artifact type of Notation signatures.
+	ArtifactTypeNotationSignature = "application/vnd.cncf.notary.signature"
+	// AccessoryTypeNotationSignature is the Harbor accessory type of Notation signatures.
+	AccessoryTypeNotationSignature = "signature.notation"
+
+	mediaTypeImageManifest = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeImageIndex    = "application/vnd.oci.image.index.v1+json"
+
+	// accessoryPageSize is the largest page size accepted by the Harbor accessories API.
+	accessoryPageSize = 100
+)
+
+type Accessory struct {
+	ID                int64  `json:"id"`
+	ArtifactID        int64  `json:"artifact_id"`
+	SubjectArtifactID int64  `json:"subject_artifact_id"`
+	Size              int64  `json:"size"`
+	Digest            string `json:"digest"`
+	Type              string `json:"type"`
+}
+
+// Descriptor is an OCI content descriptor.
+type Descriptor struct {
+	MediaType    string            `json:"mediaType"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Digest       string            `json:"digest"`
+	Size         int64             `json:"size"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is an OCI image manifest, as used by signatures and other artifacts referring to an artifact.
+type Manifest struct {
+	MediaType    string        `json:"mediaType"`
+	ArtifactType string        `json:"artifactType,omitempty"`
+	Config       *Descriptor   `json:"config"`
+	Layers       []*Descriptor `json:"layers"`
+	Subject      *Descriptor   `json:"subject,omitempty"`
+}
+
+type index struct {
+	Manifests []*Descriptor `json:"manifests"`
+}
+
+// registryPath returns the path of a repository in the OCI distribution API.
+func registryPath(projectName string, repoName string) string {
+	return fmt.Sprintf("/v2/%s/%s", projectName, repoName)
+}
+
+// GetArtifactAccessories lists the accessories of an artifact, such as its
+// signatures and SBOMs. When accessoryType is not empty only accessories of
+// that type are returned.
+func (client *Client) GetArtifactAccessories(projectName string, repoName string, reference string, accessoryType string) ([]*Accessory, error) {
+	var accessories []*Accessory
+	path := fmt.Sprintf("%s/artifacts/%s/accessories", RepositoryID(projectName, repoName), reference)
+
+	for page := 1; ; page++ {
+		var accessoryPage []*Accessory
+		params := map[string]string{
+			"page":      strconv.Itoa(page),
+			"page_size": strconv.Itoa(accessoryPageSize),
+		}
+		if accessoryType != "" {
+			params["q"] = fmt.Sprintf("type=%s", accessoryType)
+		}
+
+		err := client.get(APIURLVersion2, path, &accessoryPage, params)
+		if err != nil {
+			return nil, err
+		}
+
+		accessories = append(accessories, accessoryPage...)
+		if len(accessoryPage) < accessoryPageSize {
+			break
+		}
+	}
+
+	return accessories, nil
+}
+
+// GetReferrers lists the manifests referring to an artifact through the OCI
+// referrers API. When artifactType is not empty only referrers of that
+// artifact type are returned.
+func (client *Client) GetReferrers(projectName string, repoName string, digest string, artifactType string) ([]*Descriptor, error) {
+	path := fmt.Sprintf("%s/referrers/%s", registryPath(projectName, repoName), digest)
+	if artifactType != "" {
+		path = fmt.Sprintf("%s?artifactType=%s", path, artifactType)
+	}
+
+	body, err := client.getRaw(path, mediaTypeImageIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	var referrers index
+	err = json.Unmarshal(body, &referrers)
+	if err != nil {
+		return nil, err
+	}
+
+	// registries may ignore the artifactType filter, so apply it again
+	var descriptors []*Descriptor
+	for _, descriptor := range referrers.Manifests {
+		if artifactType == "" || descriptor.ArtifactType == artifactType {
+			descriptors = append(descriptors, descriptor)
+		}
+	}
+
+	return descriptors, nil
+}
+
+// GetSignatureDigests returns the manifest digests of the Notation signatures
+// of an artifact. The OCI referrers API is used when Harbor supports it, and
+// the accessories of the artifact otherwise.
+func (client *Client) GetSignatureDigests(projectName string, repoName string, digest string) ([]string, error) {
+	var digests []string
+
+	referrers, err := client.GetReferrers(projectName, repoName, digest, ArtifactTypeNotationSignature)
+	if err == nil {
+		for _, referrer := range referrers {
+			digests = append(digests, referrer.Digest)
+		}
+		return digests, nil
+	}
+	if !ErrorIs404(err) {
+		return nil, err
+	}
+
+	accessories, err := client.GetArtifactAccessories(projectName, repoName, digest, AccessoryTypeNotationSignature)
+	if err != nil {
+		return nil, err
+	}
+	for _, accessory := range accessories {
+		digests = append(digests, accessory.Digest)
+	}
+
+	return digests, nil
+}
+
+func (client *Client) GetManifest(projectName string, repoName string, reference string) (*Manifest, error) {
+	body, err := client.getRaw(fmt.Sprintf("%s/manifests/%s", registryPath(projectName, repoName), reference), mediaTypeImageManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest *Manifest
+	err = json.Unmarshal(body, &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (client *Client) GetBlob(projectName string, repoName string, digest string) ([]byte, error) {
+	return client.getRaw(fmt.Sprintf("%s/blobs/%s", registryPath(projectName, repoName), digest), "*/*")
+}
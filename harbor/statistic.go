@@ -0,0 +1,25 @@
+package harbor
+
+type Statistic struct {
+	PrivateProjectCount     int64 `json:"private_project_count"`
+	PrivateRepoCount        int64 `json:"private_repo_count"`
+	PublicProjectCount      int64 `json:"public_project_count"`
+	PublicRepoCount         int64 `json:"public_repo_count"`
+	TotalProjectCount       int64 `json:"total_project_count"`
+	TotalRepoCount          int64 `json:"total_repo_count"`
+	TotalStorageConsumption int64 `json:"total_storage_consumption"`
+}
+
+// GetStatistic gets the project and repository counts visible to the
+// provider user. The storage consumption is only returned to system
+// administrators.
+func (client *Client) GetStatistic() (*Statistic, error) {
+	var statistic *Statistic
+
+	err := client.get(APIURLVersion2, "/statistics", &statistic, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return statistic, nil
+}
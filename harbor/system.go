@@ -0,0 +1,65 @@
+package harbor
+
+type SystemInfo struct {
+	HarborVersion   string `json:"harbor_version"`
+	AuthMode        string `json:"auth_mode"`
+	RegistryURL     string `json:"registry_url"`
+	ExternalURL     string `json:"external_url"`
+	WithChartmuseum bool   `json:"with_chartmuseum"`
+	WithNotary      bool   `json:"with_notary"`
+}
+
+func (client *Client) GetSystemInfo() (*SystemInfo, error) {
+	var info *SystemInfo
+
+	err := client.get(APIURLVersion2, "/systeminfo", &info, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+type SystemVolumes struct {
+	Storage []*Storage `json:"storage"`
+}
+
+type Storage struct {
+	Total uint64 `json:"total"`
+	Free  uint64 `json:"free"`
+}
+
+type OverallHealth struct {
+	Status     string             `json:"status"`
+	Components []*ComponentHealth `json:"components"`
+}
+
+type ComponentHealth struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+// GetSystemVolumes gets the storage of the registry, which requires the
+// provider user to be a system administrator.
+func (client *Client) GetSystemVolumes() (*SystemVolumes, error) {
+	var volumes *SystemVolumes
+
+	err := client.get(APIURLVersion2, "/systeminfo/volumes", &volumes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumes, nil
+}
+
+func (client *Client) GetHealth() (*OverallHealth, error) {
+	var health *OverallHealth
+
+	err := client.get(APIURLVersion2, "/health", &health, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
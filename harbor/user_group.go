@@ -0,0 +1,38 @@
+package harbor
+
+const (
+	UserGroupTypeLDAP = 1
+	UserGroupTypeHTTP = 2
+	UserGroupTypeOIDC = 3
+)
+
+type UserGroup struct {
+	ID          int64  `json:"id,omitempty"`
+	GroupName   string `json:"group_name"`
+	GroupType   int    `json:"group_type"`
+	LdapGroupDN string `json:"ldap_group_dn,omitempty"`
+}
+
+func (client *Client) GetUserGroup(id string) (*UserGroup, error) {
+	var group *UserGroup
+
+	err := client.get(APIURLVersion2, id, &group, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (client *Client) NewUserGroup(group *UserGroup) (string, error) {
+	_, location, err := client.post(APIURLVersion2, "/usergroups", group)
+	return location, err
+}
+
+func (client *Client) UpdateUserGroup(id string, group *UserGroup) error {
+	return client.put(APIURLVersion2, id, group)
+}
+
+func (client *Client) DeleteUserGroup(id string) error {
+	return client.delete(APIURLVersion2, id, nil)
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"unicode/utf8"
+)
+
+const (
+	cborMajorUnsigned = 0
+	cborMajorNegative = 1
+	cborMajorBytes    = 2
+	cborMajorText     = 3
+	cborMajorArray    = 4
+	cborMajorMap      = 5
+	cborMajorTag      = 6
+	cborMajorSimple   = 7
+
+	// cborMaxDepth limits the nesting of decoded items, COSE messages only
+	// nest a few levels deep.
+	cborMaxDepth = 16
+)
+
+// cborTag is a tagged CBOR data item, e.g. a COSE_Sign1 message or an epoch
+// based date and time.
+type cborTag struct {
+	Number  uint64
+	Content interface{}
+}
+
+// cborDecode decodes a single CBOR data item, as used by COSE signature
+// envelopes. Integers are decoded as int64, byte strings as []byte, text
+// strings as string, arrays as []interface{} and maps as
+// map[interface{}]interface{}. Only the subset of CBOR needed by COSE is
+// supported: indefinite lengths, floating point numbers and map keys other
+// than integers and text strings are rejected.
+func cborDecode(data []byte) (interface{}, error) {
+	decoder := &cborDecoder{data: data}
+	value, err := decoder.decode(0)
+	if err != nil {
+		return nil, err
+	}
+	if decoder.offset != len(data) {
+		return nil, fmt.Errorf("invalid CBOR: %d trailing bytes", len(data)-decoder.offset)
+	}
+	return value, nil
+}
+
+type cborDecoder struct {
+	data   []byte
+	offset int
+}
+
+func (decoder *cborDecoder) remaining() uint64 {
+	return uint64(len(decoder.data) - decoder.offset)
+}
+
+func (decoder *cborDecoder) head() (byte, uint64, error) {
+	if decoder.remaining() == 0 {
+		return 0, 0, fmt.Errorf("invalid CBOR: unexpected end of data")
+	}
+	initial := decoder.data[decoder.offset]
+	decoder.offset++
+
+	major := initial >> 5
+	info := initial & 0x1f
+	if info < 24 {
+		return major, uint64(info), nil
+	}
+	if info > 27 {
+		return 0, 0, fmt.Errorf("invalid CBOR: indefinite lengths aren't supported")
+	}
+
+	size := 1 << (info - 24)
+	if decoder.remaining() < uint64(size) {
+		return 0, 0, fmt.Errorf("invalid CBOR: unexpected end of data")
+	}
+	var argument uint64
+	for _, b := range decoder.data[decoder.offset : decoder.offset+size] {
+		argument = argument<<8 | uint64(b)
+	}
+	decoder.offset += size
+	return major, argument, nil
+}
+
+func (decoder *cborDecoder) decode(depth int) (interface{}, error) {
+	if depth > cborMaxDepth {
+		return nil, fmt.Errorf("invalid CBOR: items are nested too deeply")
+	}
+
+	major, argument, err := decoder.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case cborMajorUnsigned, cborMajorNegative:
+		if argument > math.MaxInt64 {
+			return nil, fmt.Errorf("invalid CBOR: integer out of range")
+		}
+		if major == cborMajorNegative {
+			return -1 - int64(argument), nil
+		}
+		return int64(argument), nil
+	case cborMajorBytes, cborMajorText:
+		if argument > decoder.remaining() {
+			return nil, fmt.Errorf("invalid CBOR: unexpected end of data")
+		}
+		value := decoder.data[decoder.offset : decoder.offset+int(argument)]
+		decoder.offset += int(argument)
+		if major == cborMajorBytes {
+			return value, nil
+		}
+		if !utf8.Valid(value) {
+			return nil, fmt.Errorf("invalid CBOR: text string isn't valid UTF-8")
+		}
+		return string(value), nil
+	case cborMajorArray:
+		// every item takes at least a byte, which keeps a bogus length from allocating too much
+		if argument > decoder.remaining() {
+			return nil, fmt.Errorf("invalid CBOR: unexpected end of data")
+		}
+		items := make([]interface{}, 0, argument)
+		for i := uint64(0); i < argument; i++ {
+			item, err := decoder.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case cborMajorMap:
+		if argument > decoder.remaining()/2 {
+			return nil, fmt.Errorf("invalid CBOR: unexpected end of data")
+		}
+		items := make(map[interface{}]interface{}, argument)
+		for i := uint64(0); i < argument; i++ {
+			key, err := decoder.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, fmt.Errorf("invalid CBOR: unsupported map key of type %T", key)
+			}
+			if _, ok := items[key]; ok {
+				return nil, fmt.Errorf("invalid CBOR: duplicate map key %v", key)
+			}
+			value, err := decoder.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items[key] = value
+		}
+		return items, nil
+	case cborMajorTag:
+		content, err := decoder.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return cborTag{Number: argument, Content: content}, nil
+	default:
+		switch argument {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22, 23:
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid CBOR: floating point numbers and simple values aren't supported")
+	}
+}
+
+// cborEncode encodes a CBOR data item, using the same types cborDecode
+// returns. Map keys are sorted as required by the core deterministic
+// encoding of RFC 8949.
+func cborEncode(value interface{}) ([]byte, error) {
+	return cborAppend(nil, value)
+}
+
+func cborAppend(buffer []byte, value interface{}) ([]byte, error) {
+	var err error
+
+	switch v := value.(type) {
+	case nil:
+		return append(buffer, cborMajorSimple<<5|22), nil
+	case bool:
+		if v {
+			return append(buffer, cborMajorSimple<<5|21), nil
+		}
+		return append(buffer, cborMajorSimple<<5|20), nil
+	case int:
+		return cborAppend(buffer, int64(v))
+	case int64:
+		if v < 0 {
+			return cborAppendHead(buffer, cborMajorNegative, uint64(-1-v)), nil
+		}
+		return cborAppendHead(buffer, cborMajorUnsigned, uint64(v)), nil
+	case []byte:
+		buffer = cborAppendHead(buffer, cborMajorBytes, uint64(len(v)))
+		return append(buffer, v...), nil
+	case string:
+		buffer = cborAppendHead(buffer, cborMajorText, uint64(len(v)))
+		return append(buffer, v...), nil
+	case []interface{}:
+		buffer = cborAppendHead(buffer, cborMajorArray, uint64(len(v)))
+		for _, item := range v {
+			buffer, err = cborAppend(buffer, item)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return buffer, nil
+	case map[interface{}]interface{}:
+		type entry struct {
+			key   []byte
+			value interface{}
+		}
+		entries := make([]entry, 0, len(v))
+		for key, item := range v {
+			encodedKey, err := cborEncode(key)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry{key: encodedKey, value: item})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return bytes.Compare(entries[i].key, entries[j].key) < 0
+		})
+
+		buffer = cborAppendHead(buffer, cborMajorMap, uint64(len(v)))
+		for _, e := range entries {
+			buffer = append(buffer, e.key...)
+			buffer, err = cborAppend(buffer, e.value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return buffer, nil
+	case cborTag:
+		buffer = cborAppendHead(buffer, cborMajorTag, v.Number)
+		return cborAppend(buffer, v.Content)
+	default:
+		return nil, fmt.Errorf("unable to encode a value of type %T as CBOR", value)
+	}
+}
+
+func cborAppendHead(buffer []byte, major byte, argument uint64) []byte {
+	var b [8]byte
+	switch {
+	case argument < 24:
+		return append(buffer, major<<5|byte(argument))
+	case argument <= math.MaxUint8:
+		return append(buffer, major<<5|24, byte(argument))
+	case argument <= math.MaxUint16:
+		binary.BigEndian.PutUint16(b[:], uint16(argument))
+		return append(append(buffer, major<<5|25), b[:2]...)
+	case argument <= math.MaxUint32:
+		binary.BigEndian.PutUint32(b[:], uint32(argument))
+		return append(append(buffer, major<<5|26), b[:4]...)
+	default:
+		binary.BigEndian.PutUint64(b[:], argument)
+		return append(append(buffer, major<<5|27), b[:8]...)
+	}
+}
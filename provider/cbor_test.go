@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func TestCBOR(t *testing.T) {
+	// examples from appendix A of RFC 8949
+	cases := []struct {
+		encoded string
+		value   interface{}
+	}{
+		{"00", int64(0)},
+		{"17", int64(23)},
+		{"1818", int64(24)},
+		{"1a000f4240", int64(1000000)},
+		{"20", int64(-1)},
+		{"3903e7", int64(-1000)},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"6449455446", "IETF"},
+		{"8301820203820405", []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+		{"a201020304", map[interface{}]interface{}{int64(1): int64(2), int64(3): int64(4)}},
+		{"a26161016162820203", map[interface{}]interface{}{"a": int64(1), "b": []interface{}{int64(2), int64(3)}}},
+		{"c11a514b67b0", cborTag{Number: 1, Content: int64(1363896240)}},
+	}
+
+	for _, c := range cases {
+		data, err := hex.DecodeString(c.encoded)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		value, err := cborDecode(data)
+		if err != nil {
+			t.Errorf("unexpected error decoding %s: %s", c.encoded, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, c.value) {
+			t.Errorf("expected %s to be decoded as %#v, got %#v", c.encoded, c.value, value)
+		}
+
+		encoded, err := cborEncode(c.value)
+		if err != nil {
+			t.Errorf("unexpected error encoding %#v: %s", c.value, err)
+			continue
+		}
+		if hex.EncodeToString(encoded) != c.encoded {
+			t.Errorf("expected %#v to be encoded as %s, got %x", c.value, c.encoded, encoded)
+		}
+	}
+}
+
+func TestCBORDecodeInvalid(t *testing.T) {
+	cases := map[string]string{
+		"empty":                "",
+		"truncated integer":    "1a000f",
+		"truncated string":     "64494554",
+		"trailing bytes":       "0000",
+		"indefinite length":    "9f0102ff",
+		"floating point":       "f93c00",
+		"invalid UTF-8":        "62c328",
+		"unsupported map key":  "a1f401",
+		"duplicate map key":    "a201020103",
+		"oversized array":      "9bffffffffffffffff",
+		"integer out of range": "1bffffffffffffffff",
+	}
+
+	for name, encoded := range cases {
+		data, err := hex.DecodeString(encoded)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if value, err := cborDecode(data); err == nil {
+			t.Errorf("%s: expected an error decoding %s, got %#v", name, encoded, value)
+		}
+	}
+}
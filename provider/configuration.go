@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+// configurationID is the ID shared by the singleton resources managing parts
+// of the Harbor system configuration.
+const configurationID = "/configurations"
+
+// mapDataToConfigurations collects the values of the given attributes, keyed
+// by their Harbor configuration item name. Computed attributes are only
+// included once set, so unmanaged items keep their current value, and
+// sensitive attributes are only included when not empty, as Harbor never
+// returns them.
+func mapDataToConfigurations(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, keys map[string]string) map[string]interface{} {
+	configurations := make(map[string]interface{})
+	for attribute, key := range keys {
+		switch {
+		case resourceSchema[attribute].Computed:
+			//nolint:staticcheck
+			if value, ok := d.GetOkExists(attribute); ok {
+				configurations[key] = value
+			}
+		case resourceSchema[attribute].Sensitive:
+			if value, ok := d.GetOk(attribute); ok {
+				configurations[key] = value
+			}
+		default:
+			configurations[key] = d.Get(attribute)
+		}
+	}
+	return configurations
+}
+
+// mapConfigurationsToData sets the given attributes from their Harbor
+// configuration items. Items missing from the configuration, such as
+// passwords which Harbor never returns, are left untouched.
+func mapConfigurationsToData(d *schema.ResourceData, configurations map[string]*harbor.ConfigurationValue, keys map[string]string) error {
+	for attribute, key := range keys {
+		configuration, ok := configurations[key]
+		if !ok || configuration == nil {
+			continue
+		}
+
+		value := configuration.Value
+		// JSON numbers are decoded as float64, but every numeric item is an integer
+		if number, ok := value.(float64); ok {
+			value = int(number)
+		}
+
+		err := d.Set(attribute, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
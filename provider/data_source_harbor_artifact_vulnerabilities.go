@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceArtifactVulnerabilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArtifactVulnerabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the artifact belongs to.",
+				Required:    true,
+			},
+			"repository_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the repository the artifact belongs to, without the project prefix.",
+				Required:    true,
+			},
+			"reference": {
+				Type:        schema.TypeString,
+				Description: "Digest or tag of the artifact.",
+				Required:    true,
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Description: "Digest of the artifact.",
+				Computed:    true,
+			},
+			"scan_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the latest scan of the artifact, e.g. 'Success', or empty if it was never scanned.",
+				Computed:    true,
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Description: "Highest severity of the vulnerabilities found, e.g. 'Critical' or 'None'.",
+				Computed:    true,
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Description: "Total number of vulnerabilities found.",
+				Computed:    true,
+			},
+			"fixable": {
+				Type:        schema.TypeInt,
+				Description: "Number of vulnerabilities with a fix available.",
+				Computed:    true,
+			},
+			"summary": {
+				Type:        schema.TypeMap,
+				Description: "Number of vulnerabilities found for each severity.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"scanner_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the scanner that produced the report.",
+				Computed:    true,
+			},
+			"scanner_vendor": {
+				Type:        schema.TypeString,
+				Description: "Vendor of the scanner that produced the report.",
+				Computed:    true,
+			},
+			"scanner_version": {
+				Type:        schema.TypeString,
+				Description: "Version of the scanner that produced the report.",
+				Computed:    true,
+			},
+			"generated_at": {
+				Type:        schema.TypeString,
+				Description: "When the report was generated.",
+				Computed:    true,
+			},
+			"vulnerabilities": {
+				Type:        schema.TypeList,
+				Description: "The vulnerabilities found in the artifact.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"package": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fix_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"links": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cvss_score_v3": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"cvss_vector_v3": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cvss_score_v2": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"cvss_vector_v2": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// nativeReportMimeTypes are the media types of the native vulnerability
+// reports, the latest first. Artifacts may also have reports of other types,
+// e.g. SBOMs, which are ignored.
+var nativeReportMimeTypes = []string{harbor.MimeTypeNativeReport, harbor.MimeTypeNativeReportLegacy}
+
+// nativeScanOverview returns the overview of the native vulnerability report
+// of an artifact, which is empty when the artifact was never scanned.
+func nativeScanOverview(overviews map[string]*harbor.NativeReportSummary) *harbor.NativeReportSummary {
+	for _, mimeType := range nativeReportMimeTypes {
+		if overview := overviews[mimeType]; overview != nil {
+			return overview
+		}
+	}
+	return &harbor.NativeReportSummary{}
+}
+
+// nativeVulnerabilityReport returns the native vulnerability report among the
+// reports of an artifact, which is empty when there isn't one.
+func nativeVulnerabilityReport(reports map[string]*harbor.VulnerabilityReport) *harbor.VulnerabilityReport {
+	for _, mimeType := range nativeReportMimeTypes {
+		if report := reports[mimeType]; report != nil {
+			return report
+		}
+	}
+	return &harbor.VulnerabilityReport{}
+}
+
+func mapScanReportSummaryToData(d *schema.ResourceData, report *harbor.NativeReportSummary) error {
+	summary := &harbor.VulnerabilitySummary{}
+	if report.Summary != nil {
+		summary = report.Summary
+	}
+
+	err := d.Set("scan_status", report.ScanStatus)
+	if err != nil {
+		return err
+	}
+	err = d.Set("severity", report.Severity)
+	if err != nil {
+		return err
+	}
+	err = d.Set("total", summary.Total)
+	if err != nil {
+		return err
+	}
+	err = d.Set("fixable", summary.Fixable)
+	if err != nil {
+		return err
+	}
+	err = d.Set("summary", summary.Summary)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func mapVulnerabilityReportToData(d *schema.ResourceData, report *harbor.VulnerabilityReport) error {
+	scanner := &harbor.Scanner{}
+	if report.Scanner != nil {
+		scanner = report.Scanner
+	}
+
+	vulnerabilitiesData := make([]interface{}, 0, len(report.Vulnerabilities))
+	for _, vulnerability := range report.Vulnerabilities {
+		vulnerabilityData := map[string]interface{}{
+			"id":          vulnerability.ID,
+			"package":     vulnerability.Package,
+			"version":     vulnerability.Version,
+			"fix_version": vulnerability.FixVersion,
+			"severity":    vulnerability.Severity,
+			"description": vulnerability.Description,
+			"links":       vulnerability.Links,
+		}
+		if cvss := vulnerability.PreferredCVSS; cvss != nil {
+			if cvss.ScoreV3 != nil {
+				vulnerabilityData["cvss_score_v3"] = *cvss.ScoreV3
+			}
+			if cvss.ScoreV2 != nil {
+				vulnerabilityData["cvss_score_v2"] = *cvss.ScoreV2
+			}
+			vulnerabilityData["cvss_vector_v3"] = cvss.VectorV3
+			vulnerabilityData["cvss_vector_v2"] = cvss.VectorV2
+		}
+		vulnerabilitiesData = append(vulnerabilitiesData, vulnerabilityData)
+	}
+
+	generatedAt := ""
+	if !report.GeneratedAt.IsZero() {
+		generatedAt = report.GeneratedAt.UTC().Format(time.RFC3339)
+	}
+
+	err := d.Set("scanner_name", scanner.Name)
+	if err != nil {
+		return err
+	}
+	err = d.Set("scanner_vendor", scanner.Vendor)
+	if err != nil {
+		return err
+	}
+	err = d.Set("scanner_version", scanner.Version)
+	if err != nil {
+		return err
+	}
+	err = d.Set("generated_at", generatedAt)
+	if err != nil {
+		return err
+	}
+	err = d.Set("vulnerabilities", vulnerabilitiesData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func dataSourceArtifactVulnerabilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+
+	artifact, err := client.GetArtifact(projectName, repositoryName, d.Get("reference").(string))
+	if err != nil {
+		return err
+	}
+
+	summary := nativeScanOverview(artifact.ScanOverview)
+
+	report := &harbor.VulnerabilityReport{}
+	if summary.ScanStatus != "" {
+		reports, err := client.GetArtifactVulnerabilities(projectName, repositoryName, artifact.Digest)
+		if err != nil {
+			return err
+		}
+		report = nativeVulnerabilityReport(reports)
+	}
+
+	d.SetId(fmt.Sprintf("%s/artifacts/%s/additions/vulnerabilities", harbor.RepositoryID(projectName, repositoryName), artifact.Digest))
+
+	err = d.Set("digest", artifact.Digest)
+	if err != nil {
+		return err
+	}
+	err = mapScanReportSummaryToData(d, summary)
+	if err != nil {
+		return err
+	}
+	return mapVulnerabilityReportToData(d, report)
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+// testVulnerabilityReports is the vulnerabilities addition of an artifact
+// scanned by Trivy, which also has an SBOM report.
+const testVulnerabilityReports = `{
+	"application/vnd.security.sbom.report+json; version=1.0": {
+		"generated_at": "2024-05-02T10:00:00Z",
+		"severity": ""
+	},
+	"application/vnd.security.vulnerability.report; version=1.1": {
+		"generated_at": "2024-05-01T08:30:00.123Z",
+		"scanner": {"name": "Trivy", "vendor": "Aqua Security", "version": "v0.50.1"},
+		"severity": "High",
+		"vulnerabilities": [
+			{
+				"id": "CVE-2024-0001",
+				"package": "openssl",
+				"version": "3.1.4-r0",
+				"fix_version": "3.1.4-r5",
+				"severity": "High",
+				"description": "A flaw in openssl.",
+				"links": ["https://avd.aquasec.com/nvd/cve-2024-0001"],
+				"preferred_cvss": {"score_v3": 7.5, "vector_v3": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H", "score_v2": null, "vector_v2": ""}
+			},
+			{
+				"id": "CVE-2024-0002",
+				"package": "busybox",
+				"version": "1.36.1-r15",
+				"fix_version": "",
+				"severity": "Unknown",
+				"description": "",
+				"links": null,
+				"preferred_cvss": null
+			}
+		]
+	}
+}`
+
+func testVulnerabilityReportsFixture(t *testing.T) map[string]*harbor.VulnerabilityReport {
+	var reports map[string]*harbor.VulnerabilityReport
+	err := json.Unmarshal([]byte(testVulnerabilityReports), &reports)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return reports
+}
+
+func TestNativeVulnerabilityReport(t *testing.T) {
+	reports := testVulnerabilityReportsFixture(t)
+	if report := nativeVulnerabilityReport(reports); report.Severity != "High" {
+		t.Fatalf("expected the native report to be picked, got %+v", report)
+	}
+
+	legacy := map[string]*harbor.VulnerabilityReport{
+		"application/vnd.security.sbom.report+json; version=1.0": reports["application/vnd.security.sbom.report+json; version=1.0"],
+		harbor.MimeTypeNativeReportLegacy:                        reports[harbor.MimeTypeNativeReport],
+	}
+	if report := nativeVulnerabilityReport(legacy); report.Severity != "High" {
+		t.Fatalf("expected the legacy native report to be picked, got %+v", report)
+	}
+
+	sbomOnly := map[string]*harbor.VulnerabilityReport{
+		"application/vnd.security.sbom.report+json; version=1.0": reports["application/vnd.security.sbom.report+json; version=1.0"],
+	}
+	if report := nativeVulnerabilityReport(sbomOnly); report.Scanner != nil || len(report.Vulnerabilities) != 0 {
+		t.Fatalf("expected an empty report, got %+v", report)
+	}
+}
+
+func TestNativeScanOverview(t *testing.T) {
+	overviews := map[string]*harbor.NativeReportSummary{
+		"application/vnd.security.sbom.report+json; version=1.0": {ScanStatus: "Error"},
+		harbor.MimeTypeNativeReportLegacy:                        {ScanStatus: "Stopped"},
+		harbor.MimeTypeNativeReport:                              {ScanStatus: "Success"},
+	}
+	for i := 0; i < 10; i++ {
+		if overview := nativeScanOverview(overviews); overview.ScanStatus != "Success" {
+			t.Fatalf("expected the native overview to be picked, got %+v", overview)
+		}
+	}
+
+	if overview := nativeScanOverview(nil); overview.ScanStatus != "" {
+		t.Fatalf("expected an empty overview, got %+v", overview)
+	}
+}
+
+func TestMapVulnerabilityReportToData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceArtifactVulnerabilities().Schema, map[string]interface{}{})
+
+	err := mapVulnerabilityReportToData(d, nativeVulnerabilityReport(testVulnerabilityReportsFixture(t)))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"scanner_name":    "Trivy",
+		"scanner_vendor":  "Aqua Security",
+		"scanner_version": "v0.50.1",
+		"generated_at":    "2024-05-01T08:30:00Z",
+		"vulnerabilities": []interface{}{
+			map[string]interface{}{
+				"id":             "CVE-2024-0001",
+				"package":        "openssl",
+				"version":        "3.1.4-r0",
+				"fix_version":    "3.1.4-r5",
+				"severity":       "High",
+				"description":    "A flaw in openssl.",
+				"links":          []interface{}{"https://avd.aquasec.com/nvd/cve-2024-0001"},
+				"cvss_score_v3":  7.5,
+				"cvss_vector_v3": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H",
+				"cvss_score_v2":  0.0,
+				"cvss_vector_v2": "",
+			},
+			map[string]interface{}{
+				"id":             "CVE-2024-0002",
+				"package":        "busybox",
+				"version":        "1.36.1-r15",
+				"fix_version":    "",
+				"severity":       "Unknown",
+				"description":    "",
+				"links":          []interface{}{},
+				"cvss_score_v3":  0.0,
+				"cvss_vector_v3": "",
+				"cvss_score_v2":  0.0,
+				"cvss_vector_v2": "",
+			},
+		},
+	}
+	for key, value := range expected {
+		if actual := d.Get(key); !reflect.DeepEqual(actual, value) {
+			t.Errorf("expected %s to be %#v, got %#v", key, value, actual)
+		}
+	}
+}
+
+func TestMapVulnerabilityReportToDataWithoutScanner(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceArtifactVulnerabilities().Schema, map[string]interface{}{})
+
+	err := mapVulnerabilityReportToData(d, &harbor.VulnerabilityReport{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, key := range []string{"scanner_name", "scanner_vendor", "scanner_version", "generated_at"} {
+		if actual := d.Get(key); actual != "" {
+			t.Errorf("expected %s to be empty, got %#v", key, actual)
+		}
+	}
+	if actual := d.Get("vulnerabilities").([]interface{}); len(actual) != 0 {
+		t.Errorf("expected no vulnerabilities, got %#v", actual)
+	}
+}
+
+func TestMapScanReportSummaryToData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceArtifactVulnerabilities().Schema, map[string]interface{}{})
+
+	err := mapScanReportSummaryToData(d, &harbor.NativeReportSummary{
+		ScanStatus: "Success",
+		Severity:   "High",
+		Summary: &harbor.VulnerabilitySummary{
+			Total:   3,
+			Fixable: 1,
+			Summary: map[string]int{"High": 1, "Unknown": 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"scan_status": "Success",
+		"severity":    "High",
+		"total":       3,
+		"fixable":     1,
+		"summary":     map[string]interface{}{"High": 1, "Unknown": 2},
+	}
+	for key, value := range expected {
+		if actual := d.Get(key); !reflect.DeepEqual(actual, value) {
+			t.Errorf("expected %s to be %#v, got %#v", key, value, actual)
+		}
+	}
+
+	// artifacts which were never scanned have neither a status nor a summary
+	err = mapScanReportSummaryToData(d, &harbor.NativeReportSummary{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected = map[string]interface{}{
+		"scan_status": "",
+		"severity":    "",
+		"total":       0,
+		"fixable":     0,
+		"summary":     map[string]interface{}{},
+	}
+	for key, value := range expected {
+		if actual := d.Get(key); !reflect.DeepEqual(actual, value) {
+			t.Errorf("expected %s to be %#v, got %#v", key, value, actual)
+		}
+	}
+}
+
+func TestAccHarborArtifactVulnerabilitiesDataSourceMissingArtifact(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborArtifactVulnerabilitiesDataSource(projectName, "missing", "latest"),
+				ExpectError: regexp.MustCompile("404 Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccHarborArtifactVulnerabilitiesDataSourceBasic(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	var digest string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			digest = testAccPushImage(t, projectName, "app", "latest")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborArtifactVulnerabilitiesDataSourcePushed(projectName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.harbor_artifact_vulnerabilities.vulnerabilities", "digest", &digest),
+					resource.TestCheckResourceAttr("data.harbor_artifact_vulnerabilities.vulnerabilities", "scan_status", ""),
+					resource.TestCheckResourceAttr("data.harbor_artifact_vulnerabilities.vulnerabilities", "scanner_name", ""),
+					resource.TestCheckResourceAttr("data.harbor_artifact_vulnerabilities.vulnerabilities", "vulnerabilities.#", "0"),
+				),
+			},
+			{
+				Config: testHarborArtifactVulnerabilitiesDataSourcePushed(projectName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.harbor_artifact_vulnerabilities.vulnerabilities", "digest", &digest),
+					resource.TestCheckResourceAttr("data.harbor_artifact_vulnerabilities.vulnerabilities", "scan_status", "Success"),
+					resource.TestCheckResourceAttrSet("data.harbor_artifact_vulnerabilities.vulnerabilities", "scanner_name"),
+					resource.TestCheckResourceAttrSet("data.harbor_artifact_vulnerabilities.vulnerabilities", "generated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testHarborArtifactVulnerabilitiesDataSource(projectName string, repositoryName string, reference string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+data "harbor_artifact_vulnerabilities" "vulnerabilities" {
+	project_name    = harbor_project.project.name
+	repository_name = "%s"
+	reference       = "%s"
+}
+	`, projectName, repositoryName, reference)
+}
+
+// testHarborArtifactVulnerabilitiesDataSourcePushed reads the vulnerabilities
+// of the image pushed by testAccPushImage, after scanning it when scanned is
+// set.
+func testHarborArtifactVulnerabilitiesDataSourcePushed(projectName string, scanned bool) string {
+	scan := ""
+	dependsOn := ""
+	if scanned {
+		scan = fmt.Sprintf(`
+resource "harbor_artifact_scan" "scan" {
+	project_name    = "%s"
+	repository_name = "app"
+	reference       = "latest"
+}
+		`, projectName)
+		dependsOn = "depends_on = [harbor_artifact_scan.scan]"
+	}
+
+	return fmt.Sprintf(`
+%s
+
+data "harbor_artifact_vulnerabilities" "vulnerabilities" {
+	project_name    = "%s"
+	repository_name = "app"
+	reference       = "latest"
+
+	%s
+}
+	`, scan, projectName, dependsOn)
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceArtifacts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArtifactsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the repository belongs to.",
+				Required:    true,
+			},
+			"repository_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the repository to list artifacts from, without the project prefix.",
+				Required:    true,
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Description: "If set, only artifacts with this tag are returned.",
+				Optional:    true,
+			},
+			"artifacts": {
+				Type:        schema.TypeList,
+				Description: "The artifacts in the repository, most recently pushed first.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"push_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pull_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scan_overview": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scan_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"severity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"total": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"fixable": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"summary": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeInt},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// mapScanOverviewToData maps the overview of the native vulnerability report
+// of an artifact, as a list which is empty when the artifact wasn't scanned.
+func mapScanOverviewToData(scanOverview map[string]*harbor.NativeReportSummary) []interface{} {
+	report := nativeScanOverview(scanOverview)
+	if report.ScanStatus == "" {
+		return []interface{}{}
+	}
+
+	reportData := map[string]interface{}{
+		"scan_status": report.ScanStatus,
+		"severity":    report.Severity,
+	}
+	if report.Summary != nil {
+		reportData["total"] = report.Summary.Total
+		reportData["fixable"] = report.Summary.Fixable
+		reportData["summary"] = report.Summary.Summary
+	}
+	return []interface{}{reportData}
+}
+
+func mapArtifactsToData(d *schema.ResourceData, artifacts []*harbor.Artifact) error {
+	artifactsData := make([]interface{}, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		tags := make([]string, 0, len(artifact.Tags))
+		for _, tag := range artifact.Tags {
+			tags = append(tags, tag.Name)
+		}
+
+		artifactsData = append(artifactsData, map[string]interface{}{
+			"digest":        artifact.Digest,
+			"type":          artifact.Type,
+			"tags":          tags,
+			"size":          artifact.Size,
+			"push_time":     formatOptionalTime(artifact.PushTime.UTC()),
+			"pull_time":     formatOptionalTime(artifact.PullTime.UTC()),
+			"scan_overview": mapScanOverviewToData(artifact.ScanOverview),
+		})
+	}
+
+	return d.Set("artifacts", artifactsData)
+}
+
+func dataSourceArtifactsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+	tag := d.Get("tag").(string)
+
+	query := ""
+	if tag != "" {
+		query = fmt.Sprintf("tags=%s", tag)
+	}
+
+	artifacts, err := client.GetArtifacts(projectName, repositoryName, query)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/artifacts?q=%s", harbor.RepositoryID(projectName, repositoryName), query))
+	return mapArtifactsToData(d, artifacts)
+}
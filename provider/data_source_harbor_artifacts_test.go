@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func TestAccHarborArtifactsDataSourceMissingRepository(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborArtifactsDataSource(projectName, "missing", "latest"),
+				ExpectError: regexp.MustCompile("404 Not Found"),
+			},
+		},
+	})
+}
+
+func testHarborArtifactsDataSource(projectName string, repositoryName string, tag string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+data "harbor_artifacts" "artifacts" {
+	project_name    = harbor_project.project.name
+	repository_name = "%s"
+	tag             = "%s"
+}
+	`, projectName, repositoryName, tag)
+}
+
+func TestMapArtifactsToData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceArtifacts().Schema, map[string]interface{}{})
+
+	err := mapArtifactsToData(d, []*harbor.Artifact{
+		{
+			Digest:   "sha256:scanned",
+			PushTime: time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+			ScanOverview: map[string]*harbor.NativeReportSummary{
+				"application/vnd.security.sbom.report+json; version=1.0": {ScanStatus: "Success"},
+				harbor.MimeTypeNativeReport:                              {ScanStatus: "Success", Severity: "High"},
+			},
+		},
+		{
+			Digest:   "sha256:unscanned",
+			PushTime: time.Date(2021, 6, 2, 12, 0, 0, 0, time.UTC),
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// artifacts which were never pulled have no pull time
+	expected := map[string]interface{}{
+		"artifacts.0.push_time":                "2021-06-01T12:00:00Z",
+		"artifacts.0.pull_time":                "",
+		"artifacts.0.scan_overview.#":          1,
+		"artifacts.0.scan_overview.0.severity": "High",
+		"artifacts.1.scan_overview.#":          0,
+	}
+	for key, value := range expected {
+		if actual := d.Get(key); actual != value {
+			t.Errorf("expected %s to be %#v, got %#v", key, value, actual)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceGarbageCollectionHistory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGarbageCollectionHistoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"limit": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of runs to return.",
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"last_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the most recent run, e.g. 'Success', 'Error' or 'Running'. Empty if garbage collection has never run.",
+				Computed:    true,
+			},
+			"runs": {
+				Type:        schema.TypeList,
+				Description: "The garbage collection runs, most recent first.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trigger": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameters": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mapGCHistoryToData(d *schema.ResourceData, history []*harbor.GCHistory) error {
+	lastStatus := ""
+	if len(history) > 0 {
+		lastStatus = history[0].JobStatus
+	}
+	err := d.Set("last_status", lastStatus)
+	if err != nil {
+		return err
+	}
+
+	runs := make([]interface{}, 0, len(history))
+	for _, run := range history {
+		runs = append(runs, map[string]interface{}{
+			"id":            run.ID,
+			"status":        run.JobStatus,
+			"trigger":       run.JobKind,
+			"parameters":    run.JobParameters,
+			"creation_time": run.CreationTime,
+			"update_time":   run.UpdateTime,
+		})
+	}
+	return d.Set("runs", runs)
+}
+
+func dataSourceGarbageCollectionHistoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	history, err := client.GetGCHistory(d.Get("limit").(int))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("/system/gc")
+	return mapGCHistoryToData(d, history)
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborGarbageCollectionHistoryDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "harbor_garbage_collection_history" "history" {
+	limit = 1
+}
+				`,
+				Check: resource.TestCheckResourceAttrSet("data.harbor_garbage_collection_history.history", "runs.#"),
+			},
+		},
+	})
+}
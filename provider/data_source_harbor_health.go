@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+const healthStatusHealthy = "healthy"
+
+func dataSourceHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"require_healthy": {
+				Type:        schema.TypeBool,
+				Description: "When true, reading the data source fails unless every component of Harbor is healthy.",
+				Optional:    true,
+				Default:     false,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Overall health of Harbor, either 'healthy' or 'unhealthy'.",
+				Computed:    true,
+			},
+			"components": {
+				Type:        schema.TypeList,
+				Description: "Health of each component of Harbor.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHealthRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	health, err := client.GetHealth()
+	if err != nil {
+		return err
+	}
+
+	var unhealthy []string
+	componentsData := make([]interface{}, 0, len(health.Components))
+	for _, component := range health.Components {
+		if component.Status != healthStatusHealthy {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (%s)", component.Name, component.Error))
+		}
+		componentsData = append(componentsData, map[string]interface{}{
+			"name":   component.Name,
+			"status": component.Status,
+			"error":  component.Error,
+		})
+	}
+
+	if d.Get("require_healthy").(bool) && health.Status != healthStatusHealthy {
+		return fmt.Errorf("harbor is %s, unhealthy components: %s", health.Status, strings.Join(unhealthy, ", "))
+	}
+
+	d.SetId("/health")
+
+	err = d.Set("status", health.Status)
+	if err != nil {
+		return err
+	}
+	err = d.Set("components", componentsData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceLabel() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLabelRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the label to look up.",
+				Required:    true,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Description:  "If set, the project to look up the label in. If not set, global labels are searched.",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/projects/[0-9]+$`), "validation error: project_id should be of the form '/projects/${ID_NUMBER}'"),
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Description: "Display color of the label.",
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the label.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLabelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	label, err := findLabel(client, d.Get("name").(string), d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("/labels/%d", label.ID))
+	return mapLabelToData(d, label)
+}
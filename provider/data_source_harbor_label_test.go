@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborLabelDataSourceGlobal(t *testing.T) {
+	t.Parallel()
+
+	labelName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_label"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborLabelDataSourceGlobal(labelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.harbor_label.label", "id", "harbor_label.label", "id"),
+					resource.TestCheckResourceAttr("data.harbor_label.label", "color", "#111111"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHarborLabelDataSourceProject(t *testing.T) {
+	t.Parallel()
+
+	labelName := "terraform-" + acctest.RandString(10)
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_label"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborLabelDataSourceProject(projectName, labelName),
+				Check:  resource.TestCheckResourceAttrPair("data.harbor_label.label", "id", "harbor_label.label", "id"),
+			},
+		},
+	})
+}
+
+func testHarborLabelDataSourceGlobal(name string) string {
+	return fmt.Sprintf(`
+resource "harbor_label" "label" {
+	name  = "%s"
+	color = "#111111"
+}
+
+data "harbor_label" "label" {
+	name = harbor_label.label.name
+}
+	`, name)
+}
+
+func testHarborLabelDataSourceProject(projectName string, name string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+resource "harbor_label" "label" {
+	name       = "%s"
+	project_id = harbor_project.project.id
+}
+
+data "harbor_label" "label" {
+	name       = harbor_label.label.name
+	project_id = harbor_label.label.project_id
+}
+	`, projectName, name)
+}
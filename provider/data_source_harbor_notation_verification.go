@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceNotationVerification() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNotationVerificationRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the artifact belongs to.",
+				Required:    true,
+			},
+			"repository_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the repository the artifact belongs to, without the project prefix.",
+				Required:    true,
+			},
+			"reference": {
+				Type:        schema.TypeString,
+				Description: "Digest or tag of the artifact whose signatures are verified.",
+				Required:    true,
+			},
+			"trust_store": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded root certificates the signing certificate chains must lead to.",
+				Required:    true,
+			},
+			"trusted_identities": {
+				Type:        schema.TypeList,
+				Description: "Identities trusted to sign the artifact, either '*' or of the form 'x509.subject: C=US, O=example'.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNotationIdentity,
+				},
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Description: "Digest of the artifact.",
+				Computed:    true,
+			},
+			"verified": {
+				Type:        schema.TypeBool,
+				Description: "True when at least one signature of the artifact is verified.",
+				Computed:    true,
+			},
+			"signatures": {
+				Type:        schema.TypeList,
+				Description: "The Notation signatures of the artifact.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"media_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_scheme": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_agent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiry": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func dataSourceNotationVerificationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(d.Get("trust_store").(string))) {
+		return fmt.Errorf("trust_store doesn't contain any PEM encoded certificate")
+	}
+
+	trustedIdentities := make([]string, 0, len(d.Get("trusted_identities").([]interface{})))
+	for _, identity := range d.Get("trusted_identities").([]interface{}) {
+		trustedIdentities = append(trustedIdentities, identity.(string))
+	}
+
+	artifact, err := client.GetArtifact(projectName, repositoryName, d.Get("reference").(string))
+	if err != nil {
+		return err
+	}
+
+	signatureDigests, err := client.GetSignatureDigests(projectName, repositoryName, artifact.Digest)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	verified := false
+	signaturesData := make([]interface{}, 0, len(signatureDigests))
+	for _, signatureDigest := range signatureDigests {
+		manifest, err := client.GetManifest(projectName, repositoryName, signatureDigest)
+		if err != nil {
+			return err
+		}
+		if len(manifest.Layers) != 1 {
+			return fmt.Errorf("signature %s should have exactly one layer, but has %d", signatureDigest, len(manifest.Layers))
+		}
+
+		envelope, err := client.GetBlob(projectName, repositoryName, manifest.Layers[0].Digest)
+		if err != nil {
+			return err
+		}
+
+		signature := verifyNotationSignature(manifest.Layers[0].MediaType, envelope, artifact.Digest, roots, trustedIdentities, now)
+		verified = verified || signature.Verified
+
+		signaturesData = append(signaturesData, map[string]interface{}{
+			"digest":         signatureDigest,
+			"media_type":     manifest.Layers[0].MediaType,
+			"signing_scheme": signature.SigningScheme,
+			"signer":         signature.Signer,
+			"issuer":         signature.Issuer,
+			"signing_agent":  signature.SigningAgent,
+			"signing_time":   formatOptionalTime(signature.SigningTime),
+			"expiry":         formatOptionalTime(signature.Expiry),
+			"verified":       signature.Verified,
+			"error":          signature.Error,
+		})
+	}
+
+	err = d.Set("digest", artifact.Digest)
+	if err != nil {
+		return err
+	}
+	err = d.Set("verified", verified)
+	if err != nil {
+		return err
+	}
+	err = d.Set("signatures", signaturesData)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/artifacts/%s", harbor.RepositoryID(projectName, repositoryName), artifact.Digest))
+	return nil
+}
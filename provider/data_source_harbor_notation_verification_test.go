@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborNotationVerificationDataSourceInvalidTrustStore(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborNotationVerificationDataSource(projectName, "not a certificate"),
+				ExpectError: regexp.MustCompile("trust_store doesn't contain any PEM encoded certificate"),
+			},
+		},
+	})
+}
+
+func TestAccHarborNotationVerificationDataSourceUnsigned(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	pki := newTestNotationPKI(t, func() (crypto.Signer, error) {
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	})
+	trustStore := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: pki.intermediate.Raw})
+	var digest string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			digest = testAccPushImage(t, projectName, "app", "latest")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborNotationVerificationDataSourcePushed(projectName, string(trustStore)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.harbor_notation_verification.verification", "digest", &digest),
+					resource.TestCheckResourceAttr("data.harbor_notation_verification.verification", "verified", "false"),
+					resource.TestCheckResourceAttr("data.harbor_notation_verification.verification", "signatures.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testHarborNotationVerificationDataSource(projectName string, trustStore string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+data "harbor_notation_verification" "verification" {
+	project_name       = harbor_project.project.name
+	repository_name    = "app"
+	reference          = "latest"
+	trust_store        = "%s"
+	trusted_identities = ["*"]
+}
+	`, projectName, trustStore)
+}
+
+// testHarborNotationVerificationDataSourcePushed verifies the image pushed by
+// testAccPushImage, which isn't signed.
+func testHarborNotationVerificationDataSourcePushed(projectName string, trustStore string) string {
+	return fmt.Sprintf(`
+data "harbor_notation_verification" "verification" {
+	project_name       = "%s"
+	repository_name    = "app"
+	reference          = "latest"
+	trust_store        = <<EOT
+%sEOT
+	trusted_identities = ["*"]
+}
+	`, projectName, trustStore)
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceProjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Description: "If set, only projects whose name starts with this value are returned.",
+				Optional:    true,
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Description: "If set, only public projects are returned when true, and only private projects when false.",
+				Optional:    true,
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Description: "If set, only projects owned by the user with this username are returned.",
+				Optional:    true,
+			},
+			"projects": {
+				Type:        schema.TypeList,
+				Description: "The projects visible to the provider user, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"auto_scan": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enforce_notary_signature": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enforce_cosign_signature": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"registry_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repo_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_used": {
+							Type:        schema.TypeInt,
+							Description: "Storage used by the project, in bytes, or 0 when the provider user isn't a system administrator.",
+							Computed:    true,
+						},
+						"storage_limit": {
+							Type:        schema.TypeInt,
+							Description: "Storage quota of the project, in bytes, -1 for no limit, or 0 when the provider user isn't a system administrator.",
+							Computed:    true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getProjectQuotas lists the quotas of every project, or none when the
+// provider user isn't a system administrator and so can't list quotas.
+func getProjectQuotas(client *harbor.Client) ([]*harbor.Quota, error) {
+	quotas, err := client.GetProjectQuotas()
+	if harbor.ErrorIs403(err) {
+		log.Printf("[WARN] Unable to get the quotas of projects: %s", err)
+		return nil, nil
+	}
+	return quotas, err
+}
+
+func mapProjectsToData(d *schema.ResourceData, projects []*harbor.Project, quotas map[int64]*harbor.Quota) error {
+	projectsData := make([]interface{}, 0, len(projects))
+	for _, project := range projects {
+		projectData := map[string]interface{}{
+			"id":                       fmt.Sprintf("/projects/%d", project.ProjectID),
+			"name":                     project.Name,
+			"owner_name":               project.OwnerName,
+			"public":                   project.Metadata.Public,
+			"auto_scan":                project.Metadata.AutoScan,
+			"enforce_notary_signature": project.Metadata.EnableContentTrust,
+			"enforce_cosign_signature": project.Metadata.EnableContentTrustCosign,
+			"registry_id":              project.RegistryID,
+			"repo_count":               project.RepoCount,
+			"creation_time":            project.CreationTime,
+		}
+		if quota, ok := quotas[int64(project.ProjectID)]; ok {
+			projectData["storage_used"] = quota.Used["storage"]
+			projectData["storage_limit"] = quota.Hard["storage"]
+		}
+		projectsData = append(projectsData, projectData)
+	}
+
+	return d.Set("projects", projectsData)
+}
+
+func dataSourceProjectsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	namePrefix := d.Get("name_prefix").(string)
+	owner := d.Get("owner").(string)
+
+	var public *bool
+	//nolint:staticcheck
+	if v, ok := d.GetOkExists("public"); ok {
+		value := v.(bool)
+		public = &value
+	}
+
+	// Harbor only matches names containing the filter, so the prefix is checked here
+	matches, err := client.GetProjects(namePrefix, public, owner)
+	if err != nil {
+		return err
+	}
+	projects := make([]*harbor.Project, 0, len(matches))
+	for _, project := range matches {
+		if strings.HasPrefix(project.Name, namePrefix) {
+			projects = append(projects, project)
+		}
+	}
+
+	quotaList, err := getProjectQuotas(client)
+	if err != nil {
+		return err
+	}
+	quotas := make(map[int64]*harbor.Quota, len(quotaList))
+	for _, quota := range quotaList {
+		if quota.Ref != nil {
+			quotas[quota.Ref.ID] = quota
+		}
+	}
+
+	publicFilter := ""
+	if public != nil {
+		publicFilter = fmt.Sprintf("%t", *public)
+	}
+	d.SetId(fmt.Sprintf("/projects?name_prefix=%s&public=%s&owner=%s", namePrefix, publicFilter, owner))
+	return mapProjectsToData(d, projects, quotas)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborProjectsDataSource(t *testing.T) {
+	t.Parallel()
+
+	prefix := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborProjectsDataSource(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harbor_projects.public", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.harbor_projects.public", "projects.0.id", "harbor_project.public", "id"),
+					resource.TestCheckResourceAttr("data.harbor_projects.public", "projects.0.public", "true"),
+					resource.TestCheckResourceAttr("data.harbor_projects.all", "projects.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testHarborProjectsDataSource(prefix string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "public" {
+	name   = "%[1]s-public"
+	public = true
+}
+
+resource "harbor_project" "private" {
+	name = "%[1]s-private"
+}
+
+data "harbor_projects" "public" {
+	name_prefix = "%[1]s"
+	public      = true
+
+	depends_on = [harbor_project.public, harbor_project.private]
+}
+
+data "harbor_projects" "all" {
+	name_prefix = "%[1]s"
+
+	depends_on = [harbor_project.public, harbor_project.private]
+}
+	`, prefix)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRepositoriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project to list repositories from.",
+				Required:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "If set, only repositories whose name contains this value are returned.",
+				Optional:    true,
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Description: "The repositories in the project.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"artifact_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pull_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mapRepositoriesToData(d *schema.ResourceData, projectName string, repositories []*harbor.Repository) error {
+	repositoriesData := make([]interface{}, 0, len(repositories))
+	for _, repository := range repositories {
+		repositoriesData = append(repositoriesData, map[string]interface{}{
+			"id":             harbor.RepositoryID(projectName, repository.Name),
+			"name":           strings.TrimPrefix(repository.Name, projectName+"/"),
+			"description":    repository.Description,
+			"artifact_count": repository.ArtifactCount,
+			"pull_count":     repository.PullCount,
+			"creation_time":  repository.CreationTime.UTC().Format(time.RFC3339),
+			"update_time":    repository.UpdateTime.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return d.Set("repositories", repositoriesData)
+}
+
+func dataSourceRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+	name := d.Get("name").(string)
+
+	query := ""
+	if name != "" {
+		query = fmt.Sprintf("name=~%s", name)
+	}
+
+	repositories, err := client.GetRepositories(projectName, query)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("/projects/%s/repositories?q=%s", projectName, query))
+	return mapRepositoriesToData(d, projectName, repositories)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborRepositoriesDataSourceEmptyProject(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborRepositoriesDataSource(projectName, "app"),
+				Check:  resource.TestCheckResourceAttr("data.harbor_repositories.repositories", "repositories.#", "0"),
+			},
+		},
+	})
+}
+
+func testHarborRepositoriesDataSource(projectName string, name string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+data "harbor_repositories" "repositories" {
+	project_name = harbor_project.project.name
+	name         = "%s"
+}
+	`, projectName, name)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+// vulnerabilityDatabaseUpdatedAtProperty is the scanner adapter metadata
+// property holding when the vulnerability database was last updated.
+const vulnerabilityDatabaseUpdatedAtProperty = "harbor.scanner-adapter/vulnerability-database-updated-at"
+
+func dataSourceScanAllMetrics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceScanAllMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"total": {
+				Type:        schema.TypeInt,
+				Description: "Number of artifacts included in the last scan all.",
+				Computed:    true,
+			},
+			"completed": {
+				Type:        schema.TypeInt,
+				Description: "Number of artifacts the last scan all has finished scanning.",
+				Computed:    true,
+			},
+			"ongoing": {
+				Type:        schema.TypeBool,
+				Description: "Whether a scan all is currently running.",
+				Computed:    true,
+			},
+			"trigger": {
+				Type:        schema.TypeString,
+				Description: "What triggered the last scan all, e.g. 'Manual' or 'Schedule'.",
+				Computed:    true,
+			},
+			"metrics": {
+				Type:        schema.TypeMap,
+				Description: "Number of artifacts of the last scan all in each scan status.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"vulnerability_database_updated_at": {
+				Type:        schema.TypeString,
+				Description: "When the vulnerability database of the default scanner was last updated, if the scanner reports it.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getVulnerabilityDatabaseUpdatedAt(client *harbor.Client) (string, error) {
+	scanners, err := client.GetScanners()
+	if err != nil {
+		return "", err
+	}
+
+	for _, scanner := range scanners {
+		if !scanner.IsDefault {
+			continue
+		}
+
+		metadata, err := client.GetScannerMetadata("/scanners/" + scanner.UUID)
+		if err != nil {
+			// an unreachable scanner adapter shouldn't fail reading the metrics
+			log.Printf("[WARN] Unable to get metadata of scanner %s: %s", scanner.Name, err)
+			return "", nil
+		}
+		return metadata.Properties[vulnerabilityDatabaseUpdatedAtProperty], nil
+	}
+
+	return "", nil
+}
+
+func dataSourceScanAllMetricsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	metrics, err := client.GetScanAllMetrics()
+	// this can return a 404 if scan all has never run
+	if harbor.ErrorIs404(err) {
+		metrics = &harbor.ScanAllMetrics{}
+	} else if err != nil {
+		return err
+	}
+
+	updatedAt, err := getVulnerabilityDatabaseUpdatedAt(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("/scans/all/metrics")
+
+	err = d.Set("total", metrics.Total)
+	if err != nil {
+		return err
+	}
+	err = d.Set("completed", metrics.Completed)
+	if err != nil {
+		return err
+	}
+	err = d.Set("ongoing", metrics.Ongoing)
+	if err != nil {
+		return err
+	}
+	err = d.Set("trigger", metrics.Trigger)
+	if err != nil {
+		return err
+	}
+	err = d.Set("metrics", metrics.Metrics)
+	if err != nil {
+		return err
+	}
+	err = d.Set("vulnerability_database_updated_at", updatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborScanAllMetricsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "harbor_scan_all_metrics" "metrics" {}`,
+				Check:  resource.TestCheckResourceAttrSet("data.harbor_scan_all_metrics.metrics", "total"),
+			},
+		},
+	})
+}
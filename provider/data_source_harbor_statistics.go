@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"private_project_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of private projects.",
+				Computed:    true,
+			},
+			"private_repo_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of repositories in private projects.",
+				Computed:    true,
+			},
+			"public_project_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of public projects.",
+				Computed:    true,
+			},
+			"public_repo_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of repositories in public projects.",
+				Computed:    true,
+			},
+			"total_project_count": {
+				Type:        schema.TypeInt,
+				Description: "Total number of projects.",
+				Computed:    true,
+			},
+			"total_repo_count": {
+				Type:        schema.TypeInt,
+				Description: "Total number of repositories.",
+				Computed:    true,
+			},
+			"total_storage_consumption": {
+				Type:        schema.TypeInt,
+				Description: "Storage used by every project, in bytes.",
+				Computed:    true,
+			},
+			"projects": {
+				Type:        schema.TypeList,
+				Description: "Storage usage of each project, sorted by name. Empty unless the provider user is a system administrator.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mapStatisticToData(d *schema.ResourceData, statistic *harbor.Statistic) error {
+	err := d.Set("private_project_count", statistic.PrivateProjectCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("private_repo_count", statistic.PrivateRepoCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("public_project_count", statistic.PublicProjectCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("public_repo_count", statistic.PublicRepoCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("total_project_count", statistic.TotalProjectCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("total_repo_count", statistic.TotalRepoCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("total_storage_consumption", statistic.TotalStorageConsumption)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func mapProjectQuotasToData(d *schema.ResourceData, quotas []*harbor.Quota) error {
+	sort.Slice(quotas, func(i, j int) bool {
+		return quotas[i].Ref.Name < quotas[j].Ref.Name
+	})
+
+	projectsData := make([]interface{}, 0, len(quotas))
+	for _, quota := range quotas {
+		projectsData = append(projectsData, map[string]interface{}{
+			"id":            fmt.Sprintf("/projects/%d", quota.Ref.ID),
+			"name":          quota.Ref.Name,
+			"owner_name":    quota.Ref.OwnerName,
+			"storage_used":  quota.Used["storage"],
+			"storage_limit": quota.Hard["storage"],
+		})
+	}
+
+	return d.Set("projects", projectsData)
+}
+
+func dataSourceStatisticsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	statistic, err := client.GetStatistic()
+	if err != nil {
+		return err
+	}
+
+	quotaList, err := getProjectQuotas(client)
+	if err != nil {
+		return err
+	}
+	quotas := make([]*harbor.Quota, 0, len(quotaList))
+	for _, quota := range quotaList {
+		if quota.Ref != nil {
+			quotas = append(quotas, quota)
+		}
+	}
+
+	d.SetId("/statistics")
+
+	err = mapStatisticToData(d, statistic)
+	if err != nil {
+		return err
+	}
+	return mapProjectQuotasToData(d, quotas)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborStatisticsDataSource(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborStatisticsDataSource(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.harbor_statistics.statistics", "total_project_count"),
+					resource.TestCheckResourceAttrSet("data.harbor_statistics.statistics", "total_storage_consumption"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.harbor_statistics.statistics", "projects.*", map[string]string{
+						"name":         projectName,
+						"storage_used": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testHarborStatisticsDataSource(projectName string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+data "harbor_statistics" "statistics" {
+	depends_on = [harbor_project.project]
+}
+	`, projectName)
+}
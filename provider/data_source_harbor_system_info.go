@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func dataSourceSystemInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSystemInfoRead,
+
+		Schema: map[string]*schema.Schema{
+			"harbor_version": {
+				Type:        schema.TypeString,
+				Description: "Version of Harbor, e.g. 'v2.5.0-1234abcd'.",
+				Computed:    true,
+			},
+			"auth_mode": {
+				Type:        schema.TypeString,
+				Description: "How users authenticate, e.g. 'db_auth', 'ldap_auth' or 'oidc_auth'.",
+				Computed:    true,
+			},
+			"registry_url": {
+				Type:        schema.TypeString,
+				Description: "Host name of the registry, as used in image references.",
+				Computed:    true,
+			},
+			"external_url": {
+				Type:        schema.TypeString,
+				Description: "External URL of Harbor.",
+				Computed:    true,
+			},
+			"with_chartmuseum": {
+				Type:        schema.TypeBool,
+				Description: "Whether chartmuseum is installed, so Helm chart repositories are available.",
+				Computed:    true,
+			},
+			"with_notary": {
+				Type:        schema.TypeBool,
+				Description: "Whether Notary is installed, so content trust is available.",
+				Computed:    true,
+			},
+			"storage_total": {
+				Type:        schema.TypeInt,
+				Description: "Total storage of the registry in bytes, or 0 when the provider user isn't a system administrator.",
+				Computed:    true,
+			},
+			"storage_free": {
+				Type:        schema.TypeInt,
+				Description: "Free storage of the registry in bytes, or 0 when the provider user isn't a system administrator.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func mapSystemInfoToData(d *schema.ResourceData, info *harbor.SystemInfo) error {
+	err := d.Set("harbor_version", info.HarborVersion)
+	if err != nil {
+		return err
+	}
+	err = d.Set("auth_mode", info.AuthMode)
+	if err != nil {
+		return err
+	}
+	err = d.Set("registry_url", info.RegistryURL)
+	if err != nil {
+		return err
+	}
+	err = d.Set("external_url", info.ExternalURL)
+	if err != nil {
+		return err
+	}
+	err = d.Set("with_chartmuseum", info.WithChartmuseum)
+	if err != nil {
+		return err
+	}
+	err = d.Set("with_notary", info.WithNotary)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func dataSourceSystemInfoRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	info, err := client.GetSystemInfo()
+	if err != nil {
+		return err
+	}
+
+	var total, free uint64
+	volumes, err := client.GetSystemVolumes()
+	if harbor.ErrorIs403(err) {
+		// only system administrators can see the storage
+		log.Printf("[WARN] Unable to get the storage of the registry: %s", err)
+	} else if err != nil {
+		return err
+	} else {
+		for _, storage := range volumes.Storage {
+			total += storage.Total
+			free += storage.Free
+		}
+	}
+
+	d.SetId("/systeminfo")
+
+	err = mapSystemInfoToData(d, info)
+	if err != nil {
+		return err
+	}
+	err = d.Set("storage_total", int(total))
+	if err != nil {
+		return err
+	}
+	err = d.Set("storage_free", int(free))
+	if err != nil {
+		return err
+	}
+	return nil
+}
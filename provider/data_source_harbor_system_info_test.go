@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborSystemInfoDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "harbor_system_info" "info" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.harbor_system_info.info", "harbor_version", regexp.MustCompile(`^v2\.`)),
+					resource.TestCheckResourceAttrSet("data.harbor_system_info.info", "registry_url"),
+					resource.TestCheckResourceAttrSet("data.harbor_system_info.info", "storage_total"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+const (
+	testImageManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	testImageConfigMediaType   = "application/vnd.docker.container.image.v1+json"
+	testImageLayerMediaType    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+)
+
+// testAccPushImage creates the project projectName and pushes a small image
+// to its repository repositoryName under the given tag, for acceptance tests
+// which need an artifact to exist before Terraform runs. It returns the digest
+// of the image. The project is deleted along with its repositories once the
+// test is done.
+func testAccPushImage(t *testing.T, projectName string, repositoryName string, tag string) string {
+	location, err := harborClient.NewProject(&harbor.ProjectReq{ProjectName: projectName})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	t.Cleanup(func() {
+		repos, err := harborClient.GetRepositories(projectName, "")
+		if err == nil {
+			err = harborClient.DeleteRepositories(projectName, repos)
+		}
+		if err == nil {
+			err = harborClient.DeleteProject(location)
+		}
+		if err != nil {
+			t.Errorf("unable to delete project %s: %s", projectName, err)
+		}
+	})
+
+	registry := newTestRegistry(t, projectName+"/"+repositoryName)
+
+	layer, diffID := testImageLayer(t, projectName)
+	layerDigest := registry.pushBlob(t, layer)
+
+	config, err := json.Marshal(map[string]interface{}{
+		"architecture": "amd64",
+		"os":           "linux",
+		"config":       map[string]interface{}{},
+		"rootfs": map[string]interface{}{
+			"type":     "layers",
+			"diff_ids": []string{diffID},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	configDigest := registry.pushBlob(t, config)
+
+	manifest, err := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     testImageManifestMediaType,
+		"config": map[string]interface{}{
+			"mediaType": testImageConfigMediaType,
+			"size":      len(config),
+			"digest":    configDigest,
+		},
+		"layers": []interface{}{
+			map[string]interface{}{
+				"mediaType": testImageLayerMediaType,
+				"size":      len(layer),
+				"digest":    layerDigest,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	registry.do(t, http.MethodPut, registry.url("/manifests/"+tag), testImageManifestMediaType, manifest, http.StatusCreated)
+
+	return testImageDigest(manifest)
+}
+
+// testImageLayer returns a gzipped layer holding a single file with the given
+// content, along with the digest of the uncompressed layer.
+func testImageLayer(t *testing.T, content string) ([]byte, string) {
+	var archive bytes.Buffer
+	writer := tar.NewWriter(&archive)
+	err := writer.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0644, Size: int64(len(content))})
+	if err == nil {
+		_, err = writer.Write([]byte(content))
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var layer bytes.Buffer
+	compressor := gzip.NewWriter(&layer)
+	_, err = compressor.Write(archive.Bytes())
+	if err == nil {
+		err = compressor.Close()
+	}
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return layer.Bytes(), testImageDigest(archive.Bytes())
+}
+
+func testImageDigest(content []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+}
+
+// testRegistry pushes to a repository through the registry API of the Harbor
+// instance the acceptance tests run against.
+type testRegistry struct {
+	baseURL       *url.URL
+	repository    string
+	authorization string
+	httpClient    *http.Client
+}
+
+func newTestRegistry(t *testing.T, repository string) *testRegistry {
+	baseURL, err := url.Parse(strings.TrimSuffix(os.Getenv("HARBOR_URL"), "/"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	registry := &testRegistry{
+		baseURL:    baseURL,
+		repository: repository,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				//nolint:gosec
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+				Proxy:           http.ProxyFromEnvironment,
+			},
+		},
+	}
+
+	// the registry API only accepts the bearer tokens issued by the token
+	// service of Harbor
+	tokenURL := registry.baseURL.ResolveReference(&url.URL{
+		Path:     "/service/token",
+		RawQuery: url.Values{"service": {"harbor-registry"}, "scope": {"repository:" + repository + ":pull,push"}}.Encode(),
+	})
+	request, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	request.SetBasicAuth(os.Getenv("HARBOR_USERNAME"), os.Getenv("HARBOR_PASSWORD"))
+
+	var token struct {
+		Token string `json:"token"`
+	}
+	response := registry.send(t, request, http.StatusOK)
+	err = json.Unmarshal(response, &token)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	registry.authorization = "Bearer " + token.Token
+
+	return registry
+}
+
+func (registry *testRegistry) url(path string) string {
+	return registry.baseURL.ResolveReference(&url.URL{Path: "/v2/" + registry.repository + path}).String()
+}
+
+// pushBlob uploads a blob in a single request, returning its digest.
+func (registry *testRegistry) pushBlob(t *testing.T, content []byte) string {
+	digest := testImageDigest(content)
+
+	request, err := http.NewRequest(http.MethodPost, registry.url("/blobs/uploads/"), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	request.Header.Set("Authorization", registry.authorization)
+	response, err := registry.httpClient.Do(request)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	response.Body.Close()
+	if response.StatusCode != http.StatusAccepted {
+		t.Fatalf("unable to start the upload of blob %s: %s", digest, response.Status)
+	}
+
+	location, err := response.Location()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+
+	registry.do(t, http.MethodPut, location.String(), "application/octet-stream", content, http.StatusCreated)
+	return digest
+}
+
+func (registry *testRegistry) do(t *testing.T, method string, url string, contentType string, body []byte, expectedStatus int) []byte {
+	request, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	request.Header.Set("Authorization", registry.authorization)
+	request.Header.Set("Content-Type", contentType)
+	return registry.send(t, request, expectedStatus)
+}
+
+func (registry *testRegistry) send(t *testing.T, request *http.Request, expectedStatus int) []byte {
+	response, err := registry.httpClient.Do(request)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if response.StatusCode != expectedStatus {
+		t.Fatalf("%s %s failed with %s: %s", request.Method, request.URL.Path, response.Status, body)
+	}
+	return body
+}
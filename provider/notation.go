@@ -0,0 +1,644 @@
+package provider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	// register the hash functions used by Notation signatures
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+const (
+	mediaTypeNotationJWS     = "application/jose+json"
+	mediaTypeNotationCOSE    = "application/cose"
+	mediaTypeNotationPayload = "application/vnd.cncf.notary.payload.v1+json"
+
+	notationSigningSchemeX509      = "notary.x509"
+	notationSigningSchemeAuthority = "notary.x509.signingAuthority"
+
+	notationHeaderSigningScheme        = "io.cncf.notary.signingScheme"
+	notationHeaderSigningTime          = "io.cncf.notary.signingTime"
+	notationHeaderAuthenticSigningTime = "io.cncf.notary.authenticSigningTime"
+	notationHeaderExpiry               = "io.cncf.notary.expiry"
+	notationHeaderSigningAgent         = "io.cncf.notary.signingAgent"
+
+	// COSE header labels and tags, see RFC 9052 and RFC 9360
+	coseHeaderAlgorithm   = 1
+	coseHeaderCritical    = 2
+	coseHeaderContentType = 3
+	coseHeaderX5Chain     = 33
+	coseTagSign1          = 18
+	cborTagEpochTime      = 1
+
+	notationIdentityPrefix = "x509.subject:"
+)
+
+// notationJWSEnvelope is the flattened JWS JSON serialization used by Notation.
+type notationJWSEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		X5C          []string `json:"x5c"`
+		SigningAgent string   `json:"io.cncf.notary.signingAgent"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type notationJWSProtectedHeader struct {
+	Algorithm            string     `json:"alg"`
+	Critical             []string   `json:"crit"`
+	ContentType          string     `json:"cty"`
+	SigningScheme        string     `json:"io.cncf.notary.signingScheme"`
+	SigningTime          *time.Time `json:"io.cncf.notary.signingTime"`
+	AuthenticSigningTime *time.Time `json:"io.cncf.notary.authenticSigningTime"`
+	Expiry               *time.Time `json:"io.cncf.notary.expiry"`
+}
+
+// notationEnvelope is a signature envelope read from either a JWS or a COSE
+// envelope, with the bytes that were signed.
+type notationEnvelope struct {
+	Algorithm            string
+	Critical             []string
+	ContentType          string
+	SigningScheme        string
+	SigningTime          *time.Time
+	AuthenticSigningTime *time.Time
+	Expiry               *time.Time
+	SigningAgent         string
+	Certificates         []*x509.Certificate
+	Payload              []byte
+	SigningInput         []byte
+	Signature            []byte
+}
+
+type notationPayload struct {
+	TargetArtifact struct {
+		MediaType string `json:"mediaType"`
+		Digest    string `json:"digest"`
+		Size      int64  `json:"size"`
+	} `json:"targetArtifact"`
+}
+
+// notationSignature is the outcome of verifying a single Notation signature.
+// When verification fails Error holds the reason, and whatever could be read
+// from the envelope before the failure is still set.
+type notationSignature struct {
+	SigningScheme string
+	Signer        string
+	Issuer        string
+	SigningAgent  string
+	SigningTime   time.Time
+	Expiry        time.Time
+	Verified      bool
+	Error         string
+}
+
+// notationAlgorithm is a signature algorithm allowed by Notation. Curve is
+// only set for ECDSA, and is the only curve the algorithm may be used with.
+type notationAlgorithm struct {
+	Hash  crypto.Hash
+	Curve elliptic.Curve
+}
+
+var notationAlgorithms = map[string]notationAlgorithm{
+	"PS256": {Hash: crypto.SHA256},
+	"PS384": {Hash: crypto.SHA384},
+	"PS512": {Hash: crypto.SHA512},
+	"ES256": {Hash: crypto.SHA256, Curve: elliptic.P256()},
+	"ES384": {Hash: crypto.SHA384, Curve: elliptic.P384()},
+	"ES512": {Hash: crypto.SHA512, Curve: elliptic.P521()},
+}
+
+// notationCOSEAlgorithms maps the COSE algorithm identifiers allowed by
+// Notation to their JWS names.
+var notationCOSEAlgorithms = map[int64]string{
+	-37: "PS256",
+	-38: "PS384",
+	-39: "PS512",
+	-7:  "ES256",
+	-35: "ES384",
+	-36: "ES512",
+}
+
+// verifyNotationSignature verifies a Notation signature envelope of the
+// artifact with the given digest against the trust store roots, then checks
+// the signer against the trusted identities of the trust policy.
+func verifyNotationSignature(mediaType string, envelope []byte, artifactDigest string, roots *x509.CertPool, trustedIdentities []string, now time.Time) *notationSignature {
+	signature := &notationSignature{}
+
+	var parsed *notationEnvelope
+	var err error
+	switch mediaType {
+	case mediaTypeNotationJWS:
+		parsed, err = parseNotationJWS(envelope)
+	case mediaTypeNotationCOSE:
+		parsed, err = parseNotationCOSE(envelope)
+	default:
+		err = fmt.Errorf("unknown signature envelope media type %s", mediaType)
+	}
+	if err == nil {
+		err = verifyNotationEnvelope(signature, parsed, artifactDigest, roots, trustedIdentities, now)
+	}
+	if err != nil {
+		signature.Error = err.Error()
+		return signature
+	}
+
+	signature.Verified = true
+	return signature
+}
+
+func parseNotationJWS(data []byte) (*notationEnvelope, error) {
+	var jws notationJWSEnvelope
+	err := json.Unmarshal(data, &jws)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS envelope: %s", err)
+	}
+
+	protectedJSON, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS protected header: %s", err)
+	}
+	var protected notationJWSProtectedHeader
+	err = json.Unmarshal(protectedJSON, &protected)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS protected header: %s", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS payload: %s", err)
+	}
+	signatureValue, err := base64.RawURLEncoding.DecodeString(jws.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS signature: %s", err)
+	}
+
+	certificates := make([]*x509.Certificate, 0, len(jws.Header.X5C))
+	for _, encoded := range jws.Header.X5C {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in the certificate chain: %s", err)
+		}
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in the certificate chain: %s", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return &notationEnvelope{
+		Algorithm:            protected.Algorithm,
+		Critical:             protected.Critical,
+		ContentType:          protected.ContentType,
+		SigningScheme:        protected.SigningScheme,
+		SigningTime:          protected.SigningTime,
+		AuthenticSigningTime: protected.AuthenticSigningTime,
+		Expiry:               protected.Expiry,
+		SigningAgent:         jws.Header.SigningAgent,
+		Certificates:         certificates,
+		Payload:              payload,
+		SigningInput:         []byte(jws.Protected + "." + jws.Payload),
+		Signature:            signatureValue,
+	}, nil
+}
+
+func parseNotationCOSE(data []byte) (*notationEnvelope, error) {
+	decoded, err := cborDecode(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid COSE envelope: %s", err)
+	}
+	tag, ok := decoded.(cborTag)
+	if !ok || tag.Number != coseTagSign1 {
+		return nil, fmt.Errorf("invalid COSE envelope: not a tagged COSE_Sign1 message")
+	}
+	message, ok := tag.Content.([]interface{})
+	if !ok || len(message) != 4 {
+		return nil, fmt.Errorf("invalid COSE envelope: COSE_Sign1 should be an array of four items")
+	}
+	protectedBytes, ok := message[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid COSE envelope: the protected header should be a byte string")
+	}
+	unprotected, ok := message[1].(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid COSE envelope: the unprotected header should be a map")
+	}
+	payload, ok := message[2].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid COSE envelope: the payload should be a byte string")
+	}
+	signatureValue, ok := message[3].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid COSE envelope: the signature should be a byte string")
+	}
+
+	decoded, err = cborDecode(protectedBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid COSE protected header: %s", err)
+	}
+	protected, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid COSE protected header: should be a map")
+	}
+
+	envelope := &notationEnvelope{
+		Payload:   payload,
+		Signature: signatureValue,
+	}
+
+	if algorithm, ok := protected[int64(coseHeaderAlgorithm)].(int64); ok {
+		envelope.Algorithm, ok = notationCOSEAlgorithms[algorithm]
+		if !ok {
+			envelope.Algorithm = fmt.Sprintf("COSE algorithm %d", algorithm)
+		}
+	}
+	if critical, ok := protected[int64(coseHeaderCritical)].([]interface{}); ok {
+		for _, label := range critical {
+			envelope.Critical = append(envelope.Critical, fmt.Sprint(label))
+		}
+	}
+	if contentType, ok := protected[int64(coseHeaderContentType)]; ok {
+		envelope.ContentType = fmt.Sprint(contentType)
+	}
+	if signingScheme, ok := protected[notationHeaderSigningScheme].(string); ok {
+		envelope.SigningScheme = signingScheme
+	}
+	envelope.SigningTime, err = coseTime(protected, notationHeaderSigningTime)
+	if err != nil {
+		return nil, err
+	}
+	envelope.AuthenticSigningTime, err = coseTime(protected, notationHeaderAuthenticSigningTime)
+	if err != nil {
+		return nil, err
+	}
+	envelope.Expiry, err = coseTime(protected, notationHeaderExpiry)
+	if err != nil {
+		return nil, err
+	}
+	if signingAgent, ok := unprotected[notationHeaderSigningAgent].(string); ok {
+		envelope.SigningAgent = signingAgent
+	}
+
+	// x5chain holds a single certificate on its own, and an array otherwise
+	var chain []interface{}
+	switch x5chain := unprotected[int64(coseHeaderX5Chain)].(type) {
+	case []byte:
+		chain = []interface{}{x5chain}
+	case []interface{}:
+		chain = x5chain
+	}
+	for _, item := range chain {
+		der, ok := item.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("invalid certificate in the certificate chain: should be a byte string")
+		}
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in the certificate chain: %s", err)
+		}
+		envelope.Certificates = append(envelope.Certificates, certificate)
+	}
+
+	// the Sig_structure of RFC 9052, without external additional data
+	envelope.SigningInput, err = cborEncode([]interface{}{"Signature1", protectedBytes, []byte{}, payload})
+	if err != nil {
+		return nil, err
+	}
+
+	return envelope, nil
+}
+
+// coseTime reads a header holding an epoch based date and time, if it's set.
+func coseTime(header map[interface{}]interface{}, label string) (*time.Time, error) {
+	value, ok := header[label]
+	if !ok {
+		return nil, nil
+	}
+	tag, ok := value.(cborTag)
+	if !ok || tag.Number != cborTagEpochTime {
+		return nil, fmt.Errorf("invalid COSE header %s: should be an epoch based date and time", label)
+	}
+	seconds, ok := tag.Content.(int64)
+	if !ok {
+		return nil, fmt.Errorf("invalid COSE header %s: should be a whole number of seconds", label)
+	}
+
+	t := time.Unix(seconds, 0).UTC()
+	return &t, nil
+}
+
+func verifyNotationEnvelope(signature *notationSignature, envelope *notationEnvelope, artifactDigest string, roots *x509.CertPool, trustedIdentities []string, now time.Time) error {
+	signature.SigningScheme = envelope.SigningScheme
+	signature.SigningAgent = envelope.SigningAgent
+
+	if len(envelope.Certificates) == 0 {
+		return fmt.Errorf("the signature has no certificate chain")
+	}
+	leaf := envelope.Certificates[0]
+	signature.Signer = leaf.Subject.String()
+	signature.Issuer = leaf.Issuer.String()
+
+	// the chain is verified at the signing time only when a signing authority
+	// vouches for it, otherwise it must still be valid now
+	verifyTime := now
+	switch envelope.SigningScheme {
+	case notationSigningSchemeX509:
+		if envelope.SigningTime == nil {
+			return fmt.Errorf("the signature has no signing time")
+		}
+		signature.SigningTime = *envelope.SigningTime
+	case notationSigningSchemeAuthority:
+		if envelope.AuthenticSigningTime == nil {
+			return fmt.Errorf("the signature has no authentic signing time")
+		}
+		signature.SigningTime = *envelope.AuthenticSigningTime
+		verifyTime = *envelope.AuthenticSigningTime
+	default:
+		return fmt.Errorf("unknown signing scheme %s", envelope.SigningScheme)
+	}
+	if envelope.Expiry != nil {
+		signature.Expiry = *envelope.Expiry
+	}
+
+	err := checkNotationCriticalHeaders(envelope)
+	if err != nil {
+		return err
+	}
+	if envelope.ContentType != mediaTypeNotationPayload {
+		return fmt.Errorf("unsupported payload content type %s, expected %s", envelope.ContentType, mediaTypeNotationPayload)
+	}
+
+	err = verifyNotationSignatureValue(envelope.Algorithm, leaf, envelope.SigningInput, envelope.Signature)
+	if err != nil {
+		return err
+	}
+
+	var payload notationPayload
+	err = json.Unmarshal(envelope.Payload, &payload)
+	if err != nil {
+		return fmt.Errorf("invalid payload: %s", err)
+	}
+	if payload.TargetArtifact.Digest != artifactDigest {
+		return fmt.Errorf("the signature is for artifact %s rather than %s", payload.TargetArtifact.Digest, artifactDigest)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range envelope.Certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   verifyTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return fmt.Errorf("the certificate chain isn't trusted: %s", err)
+	}
+
+	if envelope.Expiry != nil && now.After(*envelope.Expiry) {
+		return fmt.Errorf("the signature expired at %s", envelope.Expiry.Format(time.RFC3339))
+	}
+
+	if !notationIdentityTrusted(leaf, trustedIdentities) {
+		return fmt.Errorf("the signer %s isn't a trusted identity", signature.Signer)
+	}
+
+	return nil
+}
+
+// checkNotationCriticalHeaders checks the headers listed as critical are all
+// understood and set, and that the headers Notation requires to be critical
+// are listed whenever they're set.
+func checkNotationCriticalHeaders(envelope *notationEnvelope) error {
+	set := map[string]bool{
+		notationHeaderSigningScheme:        envelope.SigningScheme != "",
+		notationHeaderSigningTime:          envelope.SigningTime != nil,
+		notationHeaderAuthenticSigningTime: envelope.AuthenticSigningTime != nil,
+		notationHeaderExpiry:               envelope.Expiry != nil,
+	}
+
+	critical := make(map[string]bool, len(envelope.Critical))
+	for _, header := range envelope.Critical {
+		isSet, known := set[header]
+		if !known {
+			return fmt.Errorf("unsupported critical header %s", header)
+		}
+		if !isSet {
+			return fmt.Errorf("critical header %s is missing", header)
+		}
+		critical[header] = true
+	}
+
+	for _, header := range []string{notationHeaderSigningScheme, notationHeaderAuthenticSigningTime, notationHeaderExpiry} {
+		if set[header] && !critical[header] {
+			return fmt.Errorf("header %s must be marked critical", header)
+		}
+	}
+	return nil
+}
+
+func verifyNotationSignatureValue(algorithmName string, leaf *x509.Certificate, signingInput []byte, signatureValue []byte) error {
+	algorithm, ok := notationAlgorithms[algorithmName]
+	if !ok {
+		return fmt.Errorf("unsupported signature algorithm %s", algorithmName)
+	}
+
+	hasher := algorithm.Hash.New()
+	hasher.Write(signingInput)
+	digest := hasher.Sum(nil)
+
+	switch publicKey := leaf.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if algorithm.Curve != nil {
+			return fmt.Errorf("signature algorithm %s doesn't match the RSA key of the signer", algorithmName)
+		}
+		err := rsa.VerifyPSS(publicKey, algorithm.Hash, digest, signatureValue, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		if err != nil {
+			return fmt.Errorf("invalid signature: %s", err)
+		}
+	case *ecdsa.PublicKey:
+		if algorithm.Curve == nil {
+			return fmt.Errorf("signature algorithm %s doesn't match the ECDSA key of the signer", algorithmName)
+		}
+		if publicKey.Curve.Params().Name != algorithm.Curve.Params().Name {
+			return fmt.Errorf("signature algorithm %s doesn't match the %s key of the signer", algorithmName, publicKey.Curve.Params().Name)
+		}
+		keySize := (publicKey.Curve.Params().BitSize + 7) / 8
+		if len(signatureValue) != 2*keySize {
+			return fmt.Errorf("invalid signature: wrong length")
+		}
+		r := new(big.Int).SetBytes(signatureValue[:keySize])
+		s := new(big.Int).SetBytes(signatureValue[keySize:])
+		if !ecdsa.Verify(publicKey, digest, r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", leaf.PublicKey)
+	}
+
+	return nil
+}
+
+// notationIdentityTrusted checks the leaf certificate against the trusted
+// identities of a trust policy. Identities are either '*' or of the form
+// 'x509.subject: C=US, O=example', which matches any subject containing all
+// of the listed attributes.
+func notationIdentityTrusted(leaf *x509.Certificate, trustedIdentities []string) bool {
+	subject := map[string][]string{
+		"C":  leaf.Subject.Country,
+		"ST": leaf.Subject.Province,
+		"L":  leaf.Subject.Locality,
+		"O":  leaf.Subject.Organization,
+		"OU": leaf.Subject.OrganizationalUnit,
+	}
+	if leaf.Subject.CommonName != "" {
+		subject["CN"] = []string{leaf.Subject.CommonName}
+	}
+
+	for _, identity := range trustedIdentities {
+		if identity == "*" {
+			return true
+		}
+		if !strings.HasPrefix(identity, notationIdentityPrefix) {
+			continue
+		}
+		attributes, err := parseDistinguishedName(strings.TrimPrefix(identity, notationIdentityPrefix))
+		if err != nil {
+			continue
+		}
+		if notationSubjectMatches(subject, attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+func notationSubjectMatches(subject map[string][]string, attributes []distinguishedNameAttribute) bool {
+	for _, attribute := range attributes {
+		found := false
+		for _, value := range subject[strings.ToUpper(attribute.Type)] {
+			if value == attribute.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+type distinguishedNameAttribute struct {
+	Type  string
+	Value string
+}
+
+// parseDistinguishedName parses the string representation of a
+// distinguished name described in RFC 4514, e.g. 'C=US, O=Example\, Inc.'.
+// Attributes of multi-valued RDNs, joined with '+', are returned as if they
+// were separate RDNs.
+func parseDistinguishedName(distinguishedName string) ([]distinguishedNameAttribute, error) {
+	var attributes []distinguishedNameAttribute
+	var value []byte
+	attributeType := ""
+	parsingType := true
+	typeStart := 0
+	// the length of the value up to its last escaped or non-space character,
+	// since unescaped trailing spaces aren't part of it
+	valueLength := 0
+
+	for i := 0; i < len(distinguishedName); i++ {
+		c := distinguishedName[i]
+		if parsingType {
+			switch c {
+			case '=':
+				attributeType = strings.TrimSpace(distinguishedName[typeStart:i])
+				if attributeType == "" {
+					return nil, fmt.Errorf("invalid distinguished name %q: attribute without a type", distinguishedName)
+				}
+				parsingType = false
+				value = nil
+				valueLength = 0
+			case ',', '+':
+				return nil, fmt.Errorf("invalid distinguished name %q: attribute without a value", distinguishedName)
+			}
+			continue
+		}
+
+		switch {
+		case c == '\\':
+			if i+1 >= len(distinguishedName) {
+				return nil, fmt.Errorf("invalid distinguished name %q: ends with an escape", distinguishedName)
+			}
+			if i+2 < len(distinguishedName) && isHexDigit(distinguishedName[i+1]) && isHexDigit(distinguishedName[i+2]) {
+				value = append(value, hexDigitValue(distinguishedName[i+1])<<4|hexDigitValue(distinguishedName[i+2]))
+				i += 2
+			} else {
+				value = append(value, distinguishedName[i+1])
+				i++
+			}
+			valueLength = len(value)
+		case c == ',' || c == '+':
+			attributes = append(attributes, distinguishedNameAttribute{Type: attributeType, Value: string(value[:valueLength])})
+			parsingType = true
+			typeStart = i + 1
+		case c == ' ' && len(value) == 0:
+			// leading spaces aren't part of the value
+		default:
+			value = append(value, c)
+			if c != ' ' {
+				valueLength = len(value)
+			}
+		}
+	}
+	if parsingType {
+		return nil, fmt.Errorf("invalid distinguished name %q: attribute without a value", distinguishedName)
+	}
+
+	return append(attributes, distinguishedNameAttribute{Type: attributeType, Value: string(value[:valueLength])}), nil
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func hexDigitValue(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	default:
+		return c - '0'
+	}
+}
+
+// validateNotationIdentity is the ValidateFunc of trusted identities.
+func validateNotationIdentity(v interface{}, k string) ([]string, []error) {
+	identity := v.(string)
+	if identity == "*" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(identity, notationIdentityPrefix) {
+		return nil, []error{fmt.Errorf("%s should be '*' or of the form 'x509.subject: C=US, O=example', got %q", k, identity)}
+	}
+
+	_, err := parseDistinguishedName(strings.TrimPrefix(identity, notationIdentityPrefix))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", k, err)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,593 @@
+package provider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testNotationDigest      = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+	testNotationOtherDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+)
+
+var testNotationTime = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// testNotationPKI is a root and an intermediate certificate authority, which
+// issue leaf certificates signing with leafKey.
+type testNotationPKI struct {
+	roots           *x509.CertPool
+	intermediate    *x509.Certificate
+	intermediateKey crypto.Signer
+	leafKey         crypto.Signer
+}
+
+func newTestNotationPKI(t *testing.T, generateKey func() (crypto.Signer, error)) *testNotationPKI {
+	keys := make([]crypto.Signer, 3)
+	for i := range keys {
+		key, err := generateKey()
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		keys[i] = key
+	}
+
+	root := newTestCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotAfter:              testNotationTime.Add(72 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil, keys[0], keys[0])
+	intermediate := newTestCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Test Intermediate"},
+		NotAfter:              testNotationTime.Add(72 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, root, keys[1], keys[0])
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	return &testNotationPKI{
+		roots:           roots,
+		intermediate:    intermediate,
+		intermediateKey: keys[1],
+		leafKey:         keys[2],
+	}
+}
+
+// chain issues a leaf certificate with the given extended key usages, and
+// returns it with the intermediate certificate that issued it.
+func (pki *testNotationPKI) chain(t *testing.T, extKeyUsages ...x509.ExtKeyUsage) []*x509.Certificate {
+	leaf := newTestCertificate(t, &x509.Certificate{
+		Subject: pkix.Name{
+			Country:            []string{"US"},
+			Province:           []string{"WA"},
+			Organization:       []string{"Example, Inc."},
+			OrganizationalUnit: []string{"Release"},
+			CommonName:         "release",
+		},
+		NotAfter:    testNotationTime.Add(24 * time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: extKeyUsages,
+	}, pki.intermediate, pki.leafKey, pki.intermediateKey)
+
+	return []*x509.Certificate{leaf, pki.intermediate}
+}
+
+func newTestCertificate(t *testing.T, template *x509.Certificate, parent *x509.Certificate, key crypto.Signer, parentKey crypto.Signer) *x509.Certificate {
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	template.SerialNumber = serialNumber
+	template.NotBefore = testNotationTime.Add(-24 * time.Hour)
+	if parent == nil {
+		parent = template
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return certificate
+}
+
+// testNotationSignature describes a signature to create. When critical is nil
+// the headers Notation requires to be critical are listed, and when
+// tamperedPayload is set it replaces the payload after signing.
+type testNotationSignature struct {
+	algorithm            string
+	critical             []string
+	contentType          string
+	signingScheme        string
+	signingTime          *time.Time
+	authenticSigningTime *time.Time
+	expiry               *time.Time
+	payload              []byte
+	tamperedPayload      []byte
+	certificates         []*x509.Certificate
+	key                  crypto.Signer
+}
+
+func testNotationPayload(digest string) []byte {
+	return []byte(fmt.Sprintf(`{"targetArtifact":{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"%s","size":1024}}`, digest))
+}
+
+func (signature *testNotationSignature) criticalHeaders() []string {
+	if signature.critical != nil {
+		return signature.critical
+	}
+
+	critical := []string{notationHeaderSigningScheme}
+	if signature.authenticSigningTime != nil {
+		critical = append(critical, notationHeaderAuthenticSigningTime)
+	}
+	if signature.expiry != nil {
+		critical = append(critical, notationHeaderExpiry)
+	}
+	return critical
+}
+
+func (signature *testNotationSignature) sign(t *testing.T, signingInput []byte) []byte {
+	hash := crypto.SHA256
+	if algorithm, ok := notationAlgorithms[signature.algorithm]; ok {
+		hash = algorithm.Hash
+	}
+	hasher := hash.New()
+	hasher.Write(signingInput)
+	digest := hasher.Sum(nil)
+
+	switch key := signature.key.(type) {
+	case *rsa.PrivateKey:
+		signatureValue, err := rsa.SignPSS(rand.Reader, key, hash, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		return signatureValue
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		keySize := (key.Curve.Params().BitSize + 7) / 8
+		signatureValue := make([]byte, 2*keySize)
+		r.FillBytes(signatureValue[:keySize])
+		s.FillBytes(signatureValue[keySize:])
+		return signatureValue
+	default:
+		t.Fatalf("unsupported key type %T", signature.key)
+		return nil
+	}
+}
+
+func (signature *testNotationSignature) payloadAfterSigning() []byte {
+	if signature.tamperedPayload != nil {
+		return signature.tamperedPayload
+	}
+	return signature.payload
+}
+
+func (signature *testNotationSignature) jws(t *testing.T) []byte {
+	protected := map[string]interface{}{
+		"alg":                       signature.algorithm,
+		"crit":                      signature.criticalHeaders(),
+		"cty":                       signature.contentType,
+		notationHeaderSigningScheme: signature.signingScheme,
+	}
+	if signature.signingTime != nil {
+		protected[notationHeaderSigningTime] = signature.signingTime.Format(time.RFC3339)
+	}
+	if signature.authenticSigningTime != nil {
+		protected[notationHeaderAuthenticSigningTime] = signature.authenticSigningTime.Format(time.RFC3339)
+	}
+	if signature.expiry != nil {
+		protected[notationHeaderExpiry] = signature.expiry.Format(time.RFC3339)
+	}
+	protectedJSON, err := json.Marshal(protected)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	encodedProtected := base64.RawURLEncoding.EncodeToString(protectedJSON)
+	signatureValue := signature.sign(t, []byte(encodedProtected+"."+base64.RawURLEncoding.EncodeToString(signature.payload)))
+
+	x5c := make([]string, 0, len(signature.certificates))
+	for _, certificate := range signature.certificates {
+		x5c = append(x5c, base64.StdEncoding.EncodeToString(certificate.Raw))
+	}
+	envelope, err := json.Marshal(map[string]interface{}{
+		"payload":   base64.RawURLEncoding.EncodeToString(signature.payloadAfterSigning()),
+		"protected": encodedProtected,
+		"header": map[string]interface{}{
+			"x5c":                      x5c,
+			notationHeaderSigningAgent: "notation-go/1.0.0",
+		},
+		"signature": base64.RawURLEncoding.EncodeToString(signatureValue),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return envelope
+}
+
+func (signature *testNotationSignature) cose(t *testing.T) []byte {
+	algorithm := int64(0)
+	for identifier, name := range notationCOSEAlgorithms {
+		if name == signature.algorithm {
+			algorithm = identifier
+		}
+	}
+	critical := make([]interface{}, 0, len(signature.criticalHeaders()))
+	for _, header := range signature.criticalHeaders() {
+		critical = append(critical, header)
+	}
+
+	protected := map[interface{}]interface{}{
+		int64(coseHeaderAlgorithm):   algorithm,
+		int64(coseHeaderCritical):    critical,
+		int64(coseHeaderContentType): signature.contentType,
+		notationHeaderSigningScheme:  signature.signingScheme,
+	}
+	if signature.signingTime != nil {
+		protected[notationHeaderSigningTime] = cborTag{Number: cborTagEpochTime, Content: signature.signingTime.Unix()}
+	}
+	if signature.authenticSigningTime != nil {
+		protected[notationHeaderAuthenticSigningTime] = cborTag{Number: cborTagEpochTime, Content: signature.authenticSigningTime.Unix()}
+	}
+	if signature.expiry != nil {
+		protected[notationHeaderExpiry] = cborTag{Number: cborTagEpochTime, Content: signature.expiry.Unix()}
+	}
+	protectedBytes, err := cborEncode(protected)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	signingInput, err := cborEncode([]interface{}{"Signature1", protectedBytes, []byte{}, signature.payload})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	signatureValue := signature.sign(t, signingInput)
+
+	chain := make([]interface{}, 0, len(signature.certificates))
+	for _, certificate := range signature.certificates {
+		chain = append(chain, certificate.Raw)
+	}
+	envelope, err := cborEncode(cborTag{Number: coseTagSign1, Content: []interface{}{
+		protectedBytes,
+		map[interface{}]interface{}{
+			int64(coseHeaderX5Chain):   chain,
+			notationHeaderSigningAgent: "notation-go/1.0.0",
+		},
+		signature.payloadAfterSigning(),
+		signatureValue,
+	}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return envelope
+}
+
+func timePointer(t time.Time) *time.Time {
+	return &t
+}
+
+func TestVerifyNotationSignature(t *testing.T) {
+	untrusted := newTestNotationPKI(t, func() (crypto.Signer, error) {
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	})
+
+	keyTypes := []struct {
+		name                string
+		algorithm           string
+		mismatchedAlgorithm string
+		generateKey         func() (crypto.Signer, error)
+	}{
+		{
+			name:                "RSA-PSS",
+			algorithm:           "PS256",
+			mismatchedAlgorithm: "ES256",
+			generateKey: func() (crypto.Signer, error) {
+				return rsa.GenerateKey(rand.Reader, 2048)
+			},
+		},
+		{
+			name:                "ECDSA P-256",
+			algorithm:           "ES256",
+			mismatchedAlgorithm: "ES384",
+			generateKey: func() (crypto.Signer, error) {
+				return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			},
+		},
+		{
+			name:                "ECDSA P-384",
+			algorithm:           "ES384",
+			mismatchedAlgorithm: "ES256",
+			generateKey: func() (crypto.Signer, error) {
+				return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+			},
+		},
+	}
+
+	for _, keyType := range keyTypes {
+		pki := newTestNotationPKI(t, keyType.generateKey)
+		chain := pki.chain(t, x509.ExtKeyUsageCodeSigning)
+		chainWithoutCodeSigning := pki.chain(t, x509.ExtKeyUsageServerAuth)
+
+		cases := []struct {
+			name              string
+			modify            func(signature *testNotationSignature)
+			roots             *x509.CertPool
+			trustedIdentities []string
+			now               time.Time
+			err               string
+		}{
+			{
+				name: "valid signature",
+			},
+			{
+				name: "wrong digest",
+				modify: func(signature *testNotationSignature) {
+					signature.payload = testNotationPayload(testNotationOtherDigest)
+				},
+				err: "the signature is for artifact " + testNotationOtherDigest,
+			},
+			{
+				name: "tampered payload",
+				modify: func(signature *testNotationSignature) {
+					signature.tamperedPayload = testNotationPayload(testNotationOtherDigest)
+				},
+				err: "invalid signature",
+			},
+			{
+				name: "expired signature",
+				modify: func(signature *testNotationSignature) {
+					signature.expiry = timePointer(testNotationTime.Add(-time.Minute))
+				},
+				err: "the signature expired at",
+			},
+			{
+				name: "signature not yet expired",
+				modify: func(signature *testNotationSignature) {
+					signature.expiry = timePointer(testNotationTime.Add(time.Hour))
+				},
+			},
+			{
+				name: "expired certificate",
+				now:  testNotationTime.Add(48 * time.Hour),
+				err:  "the certificate chain isn't trusted",
+			},
+			{
+				name: "signing authority verifies the chain at the signing time",
+				modify: func(signature *testNotationSignature) {
+					signature.signingScheme = notationSigningSchemeAuthority
+					signature.signingTime = nil
+					signature.authenticSigningTime = timePointer(testNotationTime.Add(-time.Hour))
+				},
+				now: testNotationTime.Add(48 * time.Hour),
+			},
+			{
+				name:  "untrusted root",
+				roots: untrusted.roots,
+				err:   "the certificate chain isn't trusted",
+			},
+			{
+				name: "missing code signing extended key usage",
+				modify: func(signature *testNotationSignature) {
+					signature.certificates = chainWithoutCodeSigning
+				},
+				err: "the certificate chain isn't trusted",
+			},
+			{
+				name: "missing certificate chain",
+				modify: func(signature *testNotationSignature) {
+					signature.certificates = nil
+				},
+				err: "the signature has no certificate chain",
+			},
+			{
+				name:              "matching identity",
+				trustedIdentities: []string{`x509.subject: C=US, ST=WA, O=Example\, Inc., OU=Release`},
+			},
+			{
+				name:              "matching identity among others",
+				trustedIdentities: []string{"x509.subject: C=US, O=Other", `x509.subject: CN=release, O=Example\2C Inc.`},
+			},
+			{
+				name:              "identity not matching",
+				trustedIdentities: []string{"x509.subject: C=US, ST=WA, O=Example"},
+				err:               "isn't a trusted identity",
+			},
+			{
+				name: "signing scheme not critical",
+				modify: func(signature *testNotationSignature) {
+					signature.critical = []string{}
+				},
+				err: "header io.cncf.notary.signingScheme must be marked critical",
+			},
+			{
+				name: "expiry not critical",
+				modify: func(signature *testNotationSignature) {
+					signature.expiry = timePointer(testNotationTime.Add(time.Hour))
+					signature.critical = []string{notationHeaderSigningScheme}
+				},
+				err: "header io.cncf.notary.expiry must be marked critical",
+			},
+			{
+				name: "unsupported critical header",
+				modify: func(signature *testNotationSignature) {
+					signature.critical = []string{notationHeaderSigningScheme, "io.cncf.notary.unknown"}
+				},
+				err: "unsupported critical header io.cncf.notary.unknown",
+			},
+			{
+				name: "missing critical header",
+				modify: func(signature *testNotationSignature) {
+					signature.critical = []string{notationHeaderSigningScheme, notationHeaderExpiry}
+				},
+				err: "critical header io.cncf.notary.expiry is missing",
+			},
+			{
+				name: "wrong content type",
+				modify: func(signature *testNotationSignature) {
+					signature.contentType = "application/json"
+				},
+				err: "unsupported payload content type application/json",
+			},
+			{
+				name: "algorithm not matching the key",
+				modify: func(signature *testNotationSignature) {
+					signature.algorithm = keyType.mismatchedAlgorithm
+				},
+				err: fmt.Sprintf("signature algorithm %s doesn't match the", keyType.mismatchedAlgorithm),
+			},
+		}
+
+		envelopes := []struct {
+			mediaType string
+			create    func(signature *testNotationSignature, t *testing.T) []byte
+		}{
+			{mediaTypeNotationJWS, (*testNotationSignature).jws},
+			{mediaTypeNotationCOSE, (*testNotationSignature).cose},
+		}
+
+		for _, envelope := range envelopes {
+			for _, c := range cases {
+				t.Run(fmt.Sprintf("%s/%s/%s", keyType.name, envelope.mediaType, c.name), func(t *testing.T) {
+					signature := &testNotationSignature{
+						algorithm:     keyType.algorithm,
+						contentType:   mediaTypeNotationPayload,
+						signingScheme: notationSigningSchemeX509,
+						signingTime:   timePointer(testNotationTime.Add(-time.Hour)),
+						payload:       testNotationPayload(testNotationDigest),
+						certificates:  chain,
+						key:           pki.leafKey,
+					}
+					if c.modify != nil {
+						c.modify(signature)
+					}
+					roots := pki.roots
+					if c.roots != nil {
+						roots = c.roots
+					}
+					trustedIdentities := []string{"*"}
+					if c.trustedIdentities != nil {
+						trustedIdentities = c.trustedIdentities
+					}
+					now := testNotationTime
+					if !c.now.IsZero() {
+						now = c.now
+					}
+
+					result := verifyNotationSignature(envelope.mediaType, envelope.create(signature, t), testNotationDigest, roots, trustedIdentities, now)
+
+					if c.err == "" {
+						if !result.Verified || result.Error != "" {
+							t.Fatalf("expected the signature to be verified, got error %q", result.Error)
+						}
+						if result.Signer != chain[0].Subject.String() {
+							t.Errorf("expected signer %s, got %s", chain[0].Subject, result.Signer)
+						}
+						if result.SigningAgent != "notation-go/1.0.0" {
+							t.Errorf("expected signing agent notation-go/1.0.0, got %s", result.SigningAgent)
+						}
+						return
+					}
+					if result.Verified {
+						t.Fatalf("expected the signature not to be verified")
+					}
+					if !strings.Contains(result.Error, c.err) {
+						t.Fatalf("expected error containing %q, got %q", c.err, result.Error)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestVerifyNotationSignatureUnknownMediaType(t *testing.T) {
+	result := verifyNotationSignature("application/octet-stream", []byte("{}"), testNotationDigest, x509.NewCertPool(), []string{"*"}, testNotationTime)
+	if result.Verified || result.Error != "unknown signature envelope media type application/octet-stream" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestParseDistinguishedName(t *testing.T) {
+	cases := []struct {
+		distinguishedName string
+		attributes        []distinguishedNameAttribute
+		err               bool
+	}{
+		{
+			distinguishedName: "C=US, O=Example",
+			attributes:        []distinguishedNameAttribute{{"C", "US"}, {"O", "Example"}},
+		},
+		{
+			distinguishedName: ` C = US ,O=Example\, Inc.,OU=Release`,
+			attributes:        []distinguishedNameAttribute{{"C", "US"}, {"O", "Example, Inc."}, {"OU", "Release"}},
+		},
+		{
+			distinguishedName: `O=Example\2c Inc.+OU=Release`,
+			attributes:        []distinguishedNameAttribute{{"O", "Example, Inc."}, {"OU", "Release"}},
+		},
+		{
+			distinguishedName: `CN=\ padded\ `,
+			attributes:        []distinguishedNameAttribute{{"CN", " padded "}},
+		},
+		{
+			distinguishedName: `CN=a\=b\\c`,
+			attributes:        []distinguishedNameAttribute{{"CN", `a=b\c`}},
+		},
+		{distinguishedName: "", err: true},
+		{distinguishedName: "C=US,", err: true},
+		{distinguishedName: "C=US, O", err: true},
+		{distinguishedName: "=US", err: true},
+		{distinguishedName: `O=Example\`, err: true},
+	}
+
+	for _, c := range cases {
+		attributes, err := parseDistinguishedName(c.distinguishedName)
+		if c.err {
+			if err == nil {
+				t.Errorf("expected an error parsing %q, got %v", c.distinguishedName, attributes)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", c.distinguishedName, err)
+			continue
+		}
+		if fmt.Sprint(attributes) != fmt.Sprint(c.attributes) {
+			t.Errorf("expected %q to be parsed as %v, got %v", c.distinguishedName, c.attributes, attributes)
+		}
+	}
+}
+
+func TestValidateNotationIdentity(t *testing.T) {
+	for _, identity := range []string{"*", "x509.subject: C=US, O=Example", `x509.subject: O=Example\, Inc.`} {
+		if _, errs := validateNotationIdentity(identity, "trusted_identities.0"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", identity, errs)
+		}
+	}
+	for _, identity := range []string{"", "C=US, O=Example", "x509.subject: C=US, O", "x509.subject:"} {
+		if _, errs := validateNotationIdentity(identity, "trusted_identities.0"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", identity)
+		}
+	}
+}
@@ -12,10 +12,39 @@ import (
 func New() *schema.Provider {
 	provider := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"harbor_project":       resourceProject(),
-			"harbor_robot_account": resourceRobotAccount(),
-			"harbor_webhook":       resourceWebhook(),
-			"harbor_label":         resourceLabel(),
+			"harbor_project":              resourceProject(),
+			"harbor_robot_account":        resourceRobotAccount(),
+			"harbor_webhook":              resourceWebhook(),
+			"harbor_label":                resourceLabel(),
+			"harbor_repository":           resourceRepository(),
+			"harbor_artifact_label":       resourceArtifactLabel(),
+			"harbor_group":                resourceGroup(),
+			"harbor_config_system":        resourceConfigSystem(),
+			"harbor_config_auth_ldap":     resourceConfigAuthLdap(),
+			"harbor_config_auth_oidc":     resourceConfigAuthOidc(),
+			"harbor_system_cve_allowlist": resourceSystemCVEAllowlist(),
+			"harbor_scanner":              resourceScanner(),
+			"harbor_scan_all_schedule":    resourceScanAllSchedule(),
+			"harbor_garbage_collection":   resourceGarbageCollection(),
+			"harbor_purge_audit_log":      resourcePurgeAuditLog(),
+			"harbor_project_quota":        resourceProjectQuota(),
+			"harbor_preheat_instance":     resourcePreheatInstance(),
+			"harbor_preheat_policy":       resourcePreheatPolicy(),
+			"harbor_artifact_scan":        resourceArtifactScan(),
+			"harbor_tag":                  resourceTag(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"harbor_repositories":               dataSourceRepositories(),
+			"harbor_artifacts":                  dataSourceArtifacts(),
+			"harbor_label":                      dataSourceLabel(),
+			"harbor_scan_all_metrics":           dataSourceScanAllMetrics(),
+			"harbor_garbage_collection_history": dataSourceGarbageCollectionHistory(),
+			"harbor_notation_verification":      dataSourceNotationVerification(),
+			"harbor_projects":                   dataSourceProjects(),
+			"harbor_system_info":                dataSourceSystemInfo(),
+			"harbor_health":                     dataSourceHealth(),
+			"harbor_statistics":                 dataSourceStatistics(),
+			"harbor_artifact_vulnerabilities":   dataSourceArtifactVulnerabilities(),
 		},
 		Schema: map[string]*schema.Schema{
 			"url": {
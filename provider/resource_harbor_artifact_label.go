@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var artifactLabelIDRegexp = regexp.MustCompile(`^/projects/([^/]+)/repositories/([^/]+)/artifacts/([^/]+)/labels/([0-9]+)$`)
+
+func resourceArtifactLabel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArtifactLabelCreate,
+		Read:   resourceArtifactLabelRead,
+		Delete: resourceArtifactLabelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceArtifactLabelImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the artifact belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the repository the artifact belongs to, without the project prefix.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"reference": {
+				Type:        schema.TypeString,
+				Description: "Digest or tag of the artifact to label. Tags are resolved to a digest when the label is attached.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the label to attach, in the form '/labels/${ID_NUMBER}'",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/labels/[0-9]+$`), "validation error: label_id should be of the form '/labels/${ID_NUMBER}'"),
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Description: "Digest of the labelled artifact.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func labelIDNumber(labelPath string) (int64, error) {
+	return strconv.ParseInt(strings.TrimPrefix(labelPath, "/labels/"), 10, 64)
+}
+
+func resourceArtifactLabelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	matches := artifactLabelIDRegexp.FindStringSubmatch(d.Id())
+	if matches == nil {
+		return nil, fmt.Errorf("invalid artifact label id %s, expected the form '/projects/${PROJECT_NAME}/repositories/${REPOSITORY_NAME}/artifacts/${DIGEST}/labels/${ID_NUMBER}'", d.Id())
+	}
+
+	repoName, err := unescapeRepositoryName(matches[2])
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.Set("project_name", matches[1])
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("repository_name", repoName)
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("reference", matches[3])
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("digest", matches[3])
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("label_id", "/labels/"+matches[4])
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceArtifactLabelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	labelID, err := labelIDNumber(d.Get("label_id").(string))
+	if err != nil {
+		return err
+	}
+
+	artifact, err := client.GetArtifact(d.Get("project_name").(string), d.Get("repository_name").(string), d.Get("digest").(string))
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	for _, label := range artifact.Labels {
+		if label.ID == labelID {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Removing resource with id %s from state as the label is no longer attached", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceArtifactLabelCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+
+	labelID, err := labelIDNumber(d.Get("label_id").(string))
+	if err != nil {
+		return err
+	}
+
+	artifact, err := client.GetArtifact(projectName, repositoryName, d.Get("reference").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.AddArtifactLabel(projectName, repositoryName, artifact.Digest, labelID)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("digest", artifact.Digest)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/artifacts/%s/labels/%d", harbor.RepositoryID(projectName, repositoryName), artifact.Digest, labelID))
+	return resourceArtifactLabelRead(d, meta)
+}
+
+func resourceArtifactLabelDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	labelID, err := labelIDNumber(d.Get("label_id").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.RemoveArtifactLabel(d.Get("project_name").(string), d.Get("repository_name").(string), d.Get("digest").(string), labelID)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborArtifactLabelMissingArtifact(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	labelName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_artifact_label"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborArtifactLabelBasic(projectName, labelName, "missing", "latest"),
+				ExpectError: regexp.MustCompile("404 Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccHarborArtifactLabelBasic(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	labelName := "terraform-" + acctest.RandString(10)
+	var digest string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			digest = testAccPushImage(t, projectName, "app", "latest")
+		},
+		CheckDestroy: testCheckResourceDestroy("harbor_artifact_label"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborArtifactLabelPushed(projectName, labelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("harbor_artifact_label.artifact_label", "digest", &digest),
+					resource.TestCheckResourceAttrPair("harbor_artifact_label.artifact_label", "label_id", "harbor_label.label", "id"),
+				),
+			},
+			{
+				ResourceName:      "harbor_artifact_label.artifact_label",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the tag is resolved to a digest, which is what gets imported
+				ImportStateVerifyIgnore: []string{"reference"},
+			},
+		},
+	})
+}
+
+func testHarborArtifactLabelBasic(projectName string, labelName string, repositoryName string, reference string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+resource "harbor_label" "label" {
+	name       = "%s"
+	project_id = harbor_project.project.id
+}
+
+resource "harbor_artifact_label" "artifact_label" {
+	project_name    = harbor_project.project.name
+	repository_name = "%s"
+	reference       = "%s"
+	label_id        = harbor_label.label.id
+}
+	`, projectName, labelName, repositoryName, reference)
+}
+
+// testHarborArtifactLabelPushed labels the image pushed by testAccPushImage
+// with a global label.
+func testHarborArtifactLabelPushed(projectName string, labelName string) string {
+	return fmt.Sprintf(`
+resource "harbor_label" "label" {
+	name = "%s"
+}
+
+resource "harbor_artifact_label" "artifact_label" {
+	project_name    = "%s"
+	repository_name = "app"
+	reference       = "latest"
+	label_id        = harbor_label.label.id
+}
+	`, labelName, projectName)
+}
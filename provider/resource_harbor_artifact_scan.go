@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+// scanSeverities ranks the severities reported by Harbor scanners. Unknown
+// severities aren't ranked, see scanSeverityFails.
+var scanSeverities = map[string]int{
+	"none":       0,
+	"negligible": 1,
+	"low":        2,
+	"medium":     3,
+	"high":       4,
+	"critical":   5,
+}
+
+const (
+	scanStatusNotScanned = "Not Scanned"
+	scanStatusPending    = "Pending"
+	scanStatusScheduled  = "Scheduled"
+	scanStatusRunning    = "Running"
+	scanStatusSuccess    = "Success"
+)
+
+func resourceArtifactScan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArtifactScanCreate,
+		Read:   resourceArtifactScanRead,
+		Delete: resourceArtifactScanDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the artifact belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the repository the artifact belongs to, without the project prefix.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"reference": {
+				Type:        schema.TypeString,
+				Description: "Digest or tag of the artifact to scan. Tags are resolved to a digest when the scan is requested.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"fail_on_severity": {
+				Type:         schema.TypeString,
+				Description:  "If set, the apply fails when vulnerabilities of this severity or above, or of unknown severity, are found, one of 'low', 'medium', 'high' or 'critical'.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"low", "medium", "high", "critical"}, false),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that, when changed, scan the artifact again.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Description: "Digest of the scanned artifact.",
+				Computed:    true,
+			},
+			"scan_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the latest scan of the artifact.",
+				Computed:    true,
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Description: "Highest severity of the vulnerabilities found.",
+				Computed:    true,
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Description: "Total number of vulnerabilities found.",
+				Computed:    true,
+			},
+			"fixable": {
+				Type:        schema.TypeInt,
+				Description: "Number of vulnerabilities with a fix available.",
+				Computed:    true,
+			},
+			"summary": {
+				Type:        schema.TypeMap,
+				Description: "Number of vulnerabilities found for each severity.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+// scanSeverityFails reports whether a severity found by a scan is at or above
+// the fail_on_severity threshold. A vulnerability of unknown severity may well
+// be critical, so unknown severities fail any threshold.
+func scanSeverityFails(severity string, threshold string) bool {
+	rank, ok := scanSeverities[strings.ToLower(severity)]
+	if !ok {
+		return true
+	}
+	return rank >= scanSeverities[threshold]
+}
+
+// scanFailingSeverity returns a severity found by a scan which fails the
+// fail_on_severity threshold, or "" when there's none. Harbor ranks unknown
+// severities below low when picking the overall severity, so the number of
+// vulnerabilities of each severity is checked as well.
+func scanFailingSeverity(summary *harbor.NativeReportSummary, threshold string) string {
+	severities := []string{}
+	if summary.Severity != "" {
+		severities = append(severities, summary.Severity)
+	}
+	if summary.Summary != nil {
+		counted := make([]string, 0, len(summary.Summary.Summary))
+		for severity, count := range summary.Summary.Summary {
+			if count > 0 {
+				counted = append(counted, severity)
+			}
+		}
+		sort.Strings(counted)
+		severities = append(severities, counted...)
+	}
+
+	for _, severity := range severities {
+		if scanSeverityFails(severity, threshold) {
+			return severity
+		}
+	}
+	return ""
+}
+
+// checkScanStatus tells whether the scan of an artifact finished, based on the
+// status of its scan overview. Only scans which are queued or running are
+// waited for.
+func checkScanStatus(digest string, status string) *resource.RetryError {
+	switch status {
+	case scanStatusSuccess:
+		return nil
+	case scanStatusPending, scanStatusScheduled, scanStatusRunning:
+		return resource.RetryableError(fmt.Errorf("scan of artifact %s is %s", digest, status))
+	case "", scanStatusNotScanned:
+		return resource.NonRetryableError(fmt.Errorf("scan of artifact %s wasn't started, check a scanner is configured for the project", digest))
+	default:
+		return resource.NonRetryableError(fmt.Errorf("scan of artifact %s finished with status %s", digest, status))
+	}
+}
+
+func resourceArtifactScanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	artifact, err := client.GetArtifact(d.Get("project_name").(string), d.Get("repository_name").(string), d.Get("digest").(string))
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	return mapScanReportSummaryToData(d, nativeScanOverview(artifact.ScanOverview))
+}
+
+func resourceArtifactScanCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+
+	artifact, err := client.GetArtifact(projectName, repositoryName, d.Get("reference").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.ScanArtifact(projectName, repositoryName, artifact.Digest)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("digest", artifact.Digest)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/artifacts/%s/scan", harbor.RepositoryID(projectName, repositoryName), artifact.Digest))
+
+	// the scan runs asynchronously, so wait for the scan overview to settle
+	var summary *harbor.NativeReportSummary
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		artifact, err := client.GetArtifact(projectName, repositoryName, artifact.Digest)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		summary = nativeScanOverview(artifact.ScanOverview)
+		return checkScanStatus(artifact.Digest, summary.ScanStatus)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = mapScanReportSummaryToData(d, summary)
+	if err != nil {
+		return err
+	}
+
+	// failing after the ID is set taints the resource, so the next apply scans again
+	if threshold, ok := d.GetOk("fail_on_severity"); ok {
+		if severity := scanFailingSeverity(summary, threshold.(string)); severity != "" {
+			return fmt.Errorf("artifact %s has vulnerabilities of severity %s, which fails fail_on_severity %s", artifact.Digest, severity, threshold)
+		}
+	}
+
+	return nil
+}
+
+func resourceArtifactScanDelete(d *schema.ResourceData, meta interface{}) error {
+	// scan reports can't be deleted, so destroying this resource only removes
+	// it from state and leaves the report in Harbor
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func TestAccHarborArtifactScanMissingArtifact(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborArtifactScan(projectName, "missing", "latest"),
+				ExpectError: regexp.MustCompile("404 Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccHarborArtifactScanBasic(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	var digest string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			digest = testAccPushImage(t, projectName, "app", "latest")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborArtifactScanPushed(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("harbor_artifact_scan.scan", "digest", &digest),
+					resource.TestCheckResourceAttr("harbor_artifact_scan.scan", "scan_status", "Success"),
+					// the image only holds a text file, so nothing vulnerable is found
+					resource.TestCheckResourceAttr("harbor_artifact_scan.scan", "total", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testHarborArtifactScan(projectName string, repositoryName string, reference string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+resource "harbor_artifact_scan" "scan" {
+	project_name     = harbor_project.project.name
+	repository_name  = "%s"
+	reference        = "%s"
+	fail_on_severity = "critical"
+}
+	`, projectName, repositoryName, reference)
+}
+
+// testHarborArtifactScanPushed scans the image pushed by testAccPushImage.
+func testHarborArtifactScanPushed(projectName string) string {
+	return fmt.Sprintf(`
+resource "harbor_artifact_scan" "scan" {
+	project_name     = "%s"
+	repository_name  = "app"
+	reference        = "latest"
+	fail_on_severity = "low"
+}
+	`, projectName)
+}
+
+func TestScanSeverityFails(t *testing.T) {
+	cases := []struct {
+		severity  string
+		threshold string
+		fails     bool
+	}{
+		{"None", "low", false},
+		{"Negligible", "low", false},
+		{"Low", "low", true},
+		{"Low", "medium", false},
+		{"Medium", "medium", true},
+		{"High", "medium", true},
+		{"High", "critical", false},
+		{"Critical", "critical", true},
+		{"critical", "high", true},
+		{"Unknown", "low", true},
+		{"Unknown", "critical", true},
+		{"Unrecognized", "critical", true},
+	}
+
+	for _, c := range cases {
+		if fails := scanSeverityFails(c.severity, c.threshold); fails != c.fails {
+			t.Errorf("expected severity %s with threshold %s to fail: %t, got %t", c.severity, c.threshold, c.fails, fails)
+		}
+	}
+}
+
+func TestScanFailingSeverity(t *testing.T) {
+	cases := []struct {
+		name      string
+		summary   *harbor.NativeReportSummary
+		threshold string
+		severity  string
+	}{
+		{
+			name:      "no vulnerabilities",
+			summary:   &harbor.NativeReportSummary{Severity: "None", Summary: &harbor.VulnerabilitySummary{}},
+			threshold: "low",
+		},
+		{
+			name:      "below the threshold",
+			summary:   &harbor.NativeReportSummary{Severity: "Medium", Summary: &harbor.VulnerabilitySummary{Summary: map[string]int{"Low": 2, "Medium": 1}}},
+			threshold: "high",
+		},
+		{
+			name:      "at the threshold",
+			summary:   &harbor.NativeReportSummary{Severity: "High", Summary: &harbor.VulnerabilitySummary{Summary: map[string]int{"High": 1}}},
+			threshold: "high",
+			severity:  "High",
+		},
+		{
+			name:      "unknown hidden by a higher overall severity",
+			summary:   &harbor.NativeReportSummary{Severity: "High", Summary: &harbor.VulnerabilitySummary{Summary: map[string]int{"High": 1, "Unknown": 3}}},
+			threshold: "critical",
+			severity:  "Unknown",
+		},
+		{
+			name:      "unknown overall severity",
+			summary:   &harbor.NativeReportSummary{Severity: "Unknown"},
+			threshold: "low",
+			severity:  "Unknown",
+		},
+		{
+			name:      "severities without vulnerabilities",
+			summary:   &harbor.NativeReportSummary{Severity: "Low", Summary: &harbor.VulnerabilitySummary{Summary: map[string]int{"Low": 1, "Unknown": 0}}},
+			threshold: "medium",
+		},
+	}
+
+	for _, c := range cases {
+		if severity := scanFailingSeverity(c.summary, c.threshold); severity != c.severity {
+			t.Errorf("%s: expected failing severity %q, got %q", c.name, c.severity, severity)
+		}
+	}
+}
+
+func TestCheckScanStatus(t *testing.T) {
+	cases := []struct {
+		status    string
+		finished  bool
+		retryable bool
+	}{
+		{status: "Success", finished: true},
+		{status: "Pending", retryable: true},
+		{status: "Scheduled", retryable: true},
+		{status: "Running", retryable: true},
+		{status: ""},
+		{status: "Not Scanned"},
+		{status: "Error"},
+		{status: "Stopped"},
+	}
+
+	for _, c := range cases {
+		retryError := checkScanStatus("sha256:abc", c.status)
+		if c.finished {
+			if retryError != nil {
+				t.Errorf("expected status %q to finish the scan, got %s", c.status, retryError.Err)
+			}
+			continue
+		}
+		if retryError == nil {
+			t.Errorf("expected status %q not to finish the scan", c.status)
+			continue
+		}
+		if retryError.Retryable != c.retryable {
+			t.Errorf("expected status %q to be retryable: %t, got %t", c.status, c.retryable, retryError.Retryable)
+		}
+	}
+}
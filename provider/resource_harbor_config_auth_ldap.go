@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+// configAuthLdapKeys maps attributes of harbor_config_auth_ldap to Harbor configuration items.
+var configAuthLdapKeys = map[string]string{
+	"ldap_url":        "ldap_url",
+	"search_dn":       "ldap_search_dn",
+	"search_password": "ldap_search_password",
+	"base_dn":         "ldap_base_dn",
+	"filter":          "ldap_filter",
+	"uid":             "ldap_uid",
+	"group_base_dn":   "ldap_group_base_dn",
+	"group_admin_dn":  "ldap_group_admin_dn",
+	"verify_cert":     "ldap_verify_cert",
+}
+
+var ldapScopes = map[string]int{
+	"base":     0,
+	"onelevel": 1,
+	"subtree":  2,
+}
+
+// configAuthLdapSchema is the schema of harbor_config_auth_ldap.
+var configAuthLdapSchema = map[string]*schema.Schema{
+	"ldap_url": {
+		Type:         schema.TypeString,
+		Description:  "URL of the LDAP server, e.g. 'ldaps://ldap.example.com'.",
+		Required:     true,
+		ValidateFunc: validation.IsURLWithScheme([]string{"ldap", "ldaps"}),
+	},
+	"search_dn": {
+		Type:        schema.TypeString,
+		Description: "DN of the user Harbor binds as to search for users.",
+		Optional:    true,
+	},
+	"search_password": {
+		Type:        schema.TypeString,
+		Description: "Password of the search user. Harbor never returns it, so changes made outside of Terraform aren't detected.",
+		Optional:    true,
+		Sensitive:   true,
+	},
+	"base_dn": {
+		Type:        schema.TypeString,
+		Description: "Base DN to search for users from.",
+		Required:    true,
+	},
+	"filter": {
+		Type:        schema.TypeString,
+		Description: "Additional LDAP filter applied when searching for users.",
+		Optional:    true,
+	},
+	"uid": {
+		Type:        schema.TypeString,
+		Description: "Attribute used to match the username, e.g. 'uid' or 'sAMAccountName'.",
+		Optional:    true,
+		Default:     "uid",
+	},
+	"scope": {
+		Type:         schema.TypeString,
+		Description:  "Scope of the user search, one of 'base', 'onelevel' or 'subtree'.",
+		Optional:     true,
+		Default:      "subtree",
+		ValidateFunc: validation.StringInSlice([]string{"base", "onelevel", "subtree"}, false),
+	},
+	"group_base_dn": {
+		Type:        schema.TypeString,
+		Description: "Base DN to search for groups from.",
+		Optional:    true,
+	},
+	"group_admin_dn": {
+		Type:        schema.TypeString,
+		Description: "DN of the group whose members are Harbor administrators.",
+		Optional:    true,
+	},
+	"verify_cert": {
+		Type:        schema.TypeBool,
+		Description: "When true, the certificate of the LDAP server is verified.",
+		Optional:    true,
+		Default:     true,
+	},
+}
+
+func resourceConfigAuthLdap() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceConfigAuthLdapUpdate,
+		Read:          resourceConfigAuthLdapRead,
+		Update:        resourceConfigAuthLdapUpdate,
+		Delete:        resourceConfigAuthLdapDelete,
+		CustomizeDiff: resourceConfigAuthLdapCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: configAuthLdapSchema,
+	}
+}
+
+// ldapConfData is satisfied by both *schema.ResourceData and *schema.ResourceDiff.
+type ldapConfData interface {
+	Get(key string) interface{}
+}
+
+func mapDataToLdapConf(d ldapConfData, conf *harbor.LdapConf) {
+	conf.LdapURL = d.Get("ldap_url").(string)
+	conf.LdapSearchDN = d.Get("search_dn").(string)
+	conf.LdapSearchPassword = d.Get("search_password").(string)
+	conf.LdapBaseDN = d.Get("base_dn").(string)
+	conf.LdapFilter = d.Get("filter").(string)
+	conf.LdapUID = d.Get("uid").(string)
+	conf.LdapScope = ldapScopes[d.Get("scope").(string)]
+	conf.LdapVerifyCert = d.Get("verify_cert").(bool)
+}
+
+// ldapPingAttributes are the attributes sent when pinging the LDAP server.
+var ldapPingAttributes = []string{"ldap_url", "search_dn", "search_password", "base_dn", "filter", "uid", "scope", "verify_cert"}
+
+// resourceConfigAuthLdapCustomizeDiff pings the LDAP server with the planned
+// settings, so that wrong bind credentials fail the plan rather than logins.
+// The server is only pinged when the settings change, so that an LDAP outage
+// doesn't fail unrelated plans.
+func resourceConfigAuthLdapCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		changed := false
+		for _, attribute := range ldapPingAttributes {
+			changed = changed || d.HasChange(attribute)
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	for _, attribute := range ldapPingAttributes {
+		if !d.NewValueKnown(attribute) {
+			return nil
+		}
+	}
+
+	conf := &harbor.LdapConf{}
+	mapDataToLdapConf(d, conf)
+
+	return meta.(*harbor.Client).PingLdap(conf)
+}
+
+func resourceConfigAuthLdapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	configurations, err := client.GetConfigurations()
+	if err != nil {
+		return err
+	}
+
+	err = mapConfigurationsToData(d, configurations, configAuthLdapKeys)
+	if err != nil {
+		return err
+	}
+
+	scope, ok := configurations["ldap_scope"]
+	if !ok || scope == nil {
+		return nil
+	}
+	for name, value := range ldapScopes {
+		if number, ok := scope.Value.(float64); ok && int(number) == value {
+			return d.Set("scope", name)
+		}
+	}
+	return nil
+}
+
+func resourceConfigAuthLdapUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	configurations := mapDataToConfigurations(d, configAuthLdapSchema, configAuthLdapKeys)
+	configurations["ldap_scope"] = ldapScopes[d.Get("scope").(string)]
+
+	err := client.UpdateConfigurations(configurations)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(configurationID)
+	return resourceConfigAuthLdapRead(d, meta)
+}
+
+func resourceConfigAuthLdapDelete(d *schema.ResourceData, meta interface{}) error {
+	// the LDAP configuration can't be deleted, so destroying this resource
+	// only removes it from state and leaves the server untouched
+	d.SetId("")
+	return nil
+}
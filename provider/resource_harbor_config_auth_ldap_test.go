@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborConfigAuthLdapUnreachable(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborConfigAuthLdap("ldap://ldap.invalid:389"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("failed to connect to LDAP server"),
+			},
+		},
+	})
+}
+
+func testHarborConfigAuthLdap(ldapURL string) string {
+	return fmt.Sprintf(`
+resource "harbor_config_auth_ldap" "ldap" {
+	ldap_url        = "%s"
+	search_dn       = "cn=admin,dc=example,dc=com"
+	search_password = "password"
+	base_dn         = "dc=example,dc=com"
+	verify_cert     = false
+}
+	`, ldapURL)
+}
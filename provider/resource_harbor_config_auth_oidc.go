@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+// configAuthOidcKeys maps attributes of harbor_config_auth_oidc to Harbor configuration items.
+var configAuthOidcKeys = map[string]string{
+	"name":          "oidc_name",
+	"endpoint":      "oidc_endpoint",
+	"client_id":     "oidc_client_id",
+	"client_secret": "oidc_client_secret",
+	"groups_claim":  "oidc_groups_claim",
+	"admin_group":   "oidc_admin_group",
+	"auto_onboard":  "oidc_auto_onboard",
+	"user_claim":    "oidc_user_claim",
+	"verify_cert":   "oidc_verify_cert",
+}
+
+// configAuthOidcSchema is the schema of harbor_config_auth_oidc.
+var configAuthOidcSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Description: "Name of the OIDC provider, shown on the login page.",
+		Required:    true,
+	},
+	"endpoint": {
+		Type:         schema.TypeString,
+		Description:  "URL of the OIDC provider, e.g. 'https://keycloak.example.com/auth/realms/example'.",
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPS,
+	},
+	"client_id": {
+		Type:        schema.TypeString,
+		Description: "Client ID of Harbor in the OIDC provider.",
+		Required:    true,
+	},
+	"client_secret": {
+		Type:        schema.TypeString,
+		Description: "Client secret of Harbor in the OIDC provider. Harbor never returns it, so changes made outside of Terraform aren't detected.",
+		Required:    true,
+		Sensitive:   true,
+	},
+	"scopes": {
+		Type:        schema.TypeList,
+		Description: "Scopes requested from the OIDC provider, which must include 'openid'.",
+		Optional:    true,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"groups_claim": {
+		Type:        schema.TypeString,
+		Description: "Name of the claim in the ID token holding the groups of the user.",
+		Optional:    true,
+	},
+	"admin_group": {
+		Type:        schema.TypeString,
+		Description: "Name of the group whose members are Harbor administrators.",
+		Optional:    true,
+	},
+	"auto_onboard": {
+		Type:        schema.TypeBool,
+		Description: "When true, users are created on their first login without being asked for a username.",
+		Optional:    true,
+		Default:     false,
+	},
+	"user_claim": {
+		Type:        schema.TypeString,
+		Description: "Name of the claim in the ID token used as the username when auto_onboard is true.",
+		Optional:    true,
+	},
+	"verify_cert": {
+		Type:        schema.TypeBool,
+		Description: "When true, the certificate of the OIDC provider is verified.",
+		Optional:    true,
+		Default:     true,
+	},
+}
+
+func resourceConfigAuthOidc() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigAuthOidcUpdate,
+		Read:   resourceConfigAuthOidcRead,
+		Update: resourceConfigAuthOidcUpdate,
+		Delete: resourceConfigAuthOidcDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: configAuthOidcSchema,
+	}
+}
+
+// checkAuthModeOidc ensures Harbor uses OIDC authentication, as Harbor
+// rejects OIDC settings in any other authentication mode.
+func checkAuthModeOidc(configurations map[string]*harbor.ConfigurationValue) error {
+	var authMode interface{}
+	if configuration, ok := configurations["auth_mode"]; ok && configuration != nil {
+		authMode = configuration.Value
+	}
+
+	if authMode != "oidc_auth" {
+		return fmt.Errorf("auth_mode must be 'oidc_auth' to configure OIDC authentication, but is '%v'", authMode)
+	}
+	return nil
+}
+
+func resourceConfigAuthOidcRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	configurations, err := client.GetConfigurations()
+	if err != nil {
+		return err
+	}
+
+	err = mapConfigurationsToData(d, configurations, configAuthOidcKeys)
+	if err != nil {
+		return err
+	}
+
+	scope, ok := configurations["oidc_scope"]
+	if !ok || scope == nil {
+		return nil
+	}
+	if value, ok := scope.Value.(string); ok && value != "" {
+		return d.Set("scopes", strings.Split(value, ","))
+	}
+	return nil
+}
+
+func resourceConfigAuthOidcUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	current, err := client.GetConfigurations()
+	if err != nil {
+		return err
+	}
+
+	err = checkAuthModeOidc(current)
+	if err != nil {
+		return err
+	}
+
+	configurations := mapDataToConfigurations(d, configAuthOidcSchema, configAuthOidcKeys)
+	if v, ok := d.GetOk("scopes"); ok {
+		scopes := make([]string, 0, len(v.([]interface{})))
+		for _, scope := range v.([]interface{}) {
+			scopes = append(scopes, scope.(string))
+		}
+		configurations["oidc_scope"] = strings.Join(scopes, ",")
+	}
+
+	err = client.UpdateConfigurations(configurations)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(configurationID)
+	return resourceConfigAuthOidcRead(d, meta)
+}
+
+func resourceConfigAuthOidcDelete(d *schema.ResourceData, meta interface{}) error {
+	// the OIDC configuration can't be deleted, so destroying this resource
+	// only removes it from state and leaves the server untouched
+	d.SetId("")
+	return nil
+}
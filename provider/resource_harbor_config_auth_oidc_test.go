@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborConfigAuthOidcRequiresOidcAuthMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborConfigAuthOidc(),
+				ExpectError: regexp.MustCompile("auth_mode must be 'oidc_auth'"),
+			},
+		},
+	})
+}
+
+func testHarborConfigAuthOidc() string {
+	return `
+resource "harbor_config_system" "system" {
+	auth_mode = "db_auth"
+}
+
+resource "harbor_config_auth_oidc" "oidc" {
+	name          = "keycloak"
+	endpoint      = "https://keycloak.example.com/auth/realms/example"
+	client_id     = "harbor"
+	client_secret = "secret"
+	scopes        = ["openid", "profile", "email", "offline_access"]
+	groups_claim  = "groups"
+
+	depends_on = [harbor_config_system.system]
+}
+	`
+}
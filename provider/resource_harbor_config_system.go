@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+// configSystemKeys maps attributes of harbor_config_system to Harbor configuration items.
+var configSystemKeys = map[string]string{
+	"auth_mode":                    "auth_mode",
+	"self_registration":            "self_registration",
+	"project_creation_restriction": "project_creation_restriction",
+	"token_expiration":             "token_expiration",
+	"robot_token_expiration":       "robot_token_duration",
+	"robot_name_prefix":            "robot_name_prefix",
+}
+
+// configSystemSchema is the schema of harbor_config_system.
+var configSystemSchema = map[string]*schema.Schema{
+	"auth_mode": {
+		Type:         schema.TypeString,
+		Description:  "Authentication mode of Harbor. It can only be changed while no users other than admin exist.",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"db_auth", "ldap_auth", "uaa_auth", "http_auth", "oidc_auth"}, false),
+	},
+	"self_registration": {
+		Type:        schema.TypeBool,
+		Description: "When true, users can register themselves. Only applies to database authentication.",
+		Optional:    true,
+		Computed:    true,
+	},
+	"project_creation_restriction": {
+		Type:         schema.TypeString,
+		Description:  "Who can create projects, either 'everyone' or 'adminonly'.",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"everyone", "adminonly"}, false),
+	},
+	"token_expiration": {
+		Type:         schema.TypeInt,
+		Description:  "Expiration of tokens issued by the token service, in minutes.",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"robot_token_expiration": {
+		Type:         schema.TypeInt,
+		Description:  "Default expiration of robot account tokens, in days.",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"robot_name_prefix": {
+		Type:        schema.TypeString,
+		Description: "Prefix of robot account names.",
+		Optional:    true,
+		Computed:    true,
+	},
+}
+
+func resourceConfigSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigSystemUpdate,
+		Read:   resourceConfigSystemRead,
+		Update: resourceConfigSystemUpdate,
+		Delete: resourceConfigSystemDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: configSystemSchema,
+	}
+}
+
+func resourceConfigSystemRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	configurations, err := client.GetConfigurations()
+	if err != nil {
+		return err
+	}
+
+	return mapConfigurationsToData(d, configurations, configSystemKeys)
+}
+
+func resourceConfigSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.UpdateConfigurations(mapDataToConfigurations(d, configSystemSchema, configSystemKeys))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(configurationID)
+	return resourceConfigSystemRead(d, meta)
+}
+
+func resourceConfigSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	// the system configuration can't be deleted, so destroying this resource
+	// only removes it from state and leaves the server untouched
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborConfigSystemUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborConfigSystem("adminonly", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_config_system.system"),
+					resource.TestCheckResourceAttr("harbor_config_system.system", "project_creation_restriction", "adminonly"),
+					resource.TestCheckResourceAttr("harbor_config_system.system", "token_expiration", "60"),
+					resource.TestCheckResourceAttrSet("harbor_config_system.system", "auth_mode"),
+				),
+			},
+			{
+				Config: testHarborConfigSystem("everyone", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_config_system.system", "project_creation_restriction", "everyone"),
+					resource.TestCheckResourceAttr("harbor_config_system.system", "token_expiration", "30"),
+				),
+			},
+			{
+				ResourceName:      "harbor_config_system.system",
+				ImportStateId:     "/configurations",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testHarborConfigSystem(projectCreationRestriction string, tokenExpiration int) string {
+	return fmt.Sprintf(`
+resource "harbor_config_system" "system" {
+	project_creation_restriction = "%s"
+	token_expiration             = %d
+	robot_token_expiration       = 30
+}
+	`, projectCreationRestriction, tokenExpiration)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func resourceGarbageCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGarbageCollectionUpdate,
+		Read:   resourceGarbageCollectionRead,
+		Update: resourceGarbageCollectionUpdate,
+		Delete: resourceGarbageCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: scheduleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"schedule": scheduleTypeSchema(),
+			"cron":     scheduleCronSchema(),
+			"delete_untagged": {
+				Type:        schema.TypeBool,
+				Description: "When true, untagged artifacts are deleted before blobs are collected.",
+				Optional:    true,
+				Default:     false,
+			},
+			"workers": {
+				Type:         schema.TypeInt,
+				Description:  "Number of workers deleting blobs in parallel. Requires Harbor 2.8 or later.",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+		},
+	}
+}
+
+func mapDataToGCSchedule(d *schema.ResourceData, schedule *harbor.Schedule) error {
+	err := mapDataToSchedule(d, schedule)
+	if err != nil {
+		return err
+	}
+
+	schedule.Parameters = map[string]interface{}{
+		"delete_untagged": d.Get("delete_untagged").(bool),
+		"workers":         d.Get("workers").(int),
+	}
+	return nil
+}
+
+// gcParameters are the job parameters of a garbage collection schedule.
+type gcParameters struct {
+	DeleteUntagged *bool `json:"delete_untagged"`
+	Workers        *int  `json:"workers"`
+}
+
+func mapGCScheduleToData(d *schema.ResourceData, gcSchedule *harbor.GCHistory) error {
+	schedule := &harbor.Schedule{}
+	if gcSchedule != nil {
+		schedule.Schedule = gcSchedule.Schedule
+	}
+	err := mapScheduleToData(d, schedule)
+	if err != nil {
+		return err
+	}
+	if gcSchedule == nil || gcSchedule.JobParameters == "" {
+		return nil
+	}
+
+	var parameters gcParameters
+	err = json.Unmarshal([]byte(gcSchedule.JobParameters), &parameters)
+	if err != nil {
+		return fmt.Errorf("invalid garbage collection parameters %s: %s", gcSchedule.JobParameters, err)
+	}
+
+	if parameters.DeleteUntagged != nil {
+		err = d.Set("delete_untagged", *parameters.DeleteUntagged)
+		if err != nil {
+			return err
+		}
+	}
+	if parameters.Workers != nil {
+		err = d.Set("workers", *parameters.Workers)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceGarbageCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	schedule, err := client.GetGCSchedule()
+	if err != nil {
+		return err
+	}
+
+	return mapGCScheduleToData(d, schedule)
+}
+
+func resourceGarbageCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	schedule := &harbor.Schedule{}
+	err := mapDataToGCSchedule(d, schedule)
+	if err != nil {
+		return err
+	}
+
+	err = client.SetGCSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("/system/gc/schedule")
+	return resourceGarbageCollectionRead(d, meta)
+}
+
+func resourceGarbageCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.SetGCSchedule(&harbor.Schedule{Schedule: &harbor.ScheduleObj{Type: harbor.ScheduleTypeNone}})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
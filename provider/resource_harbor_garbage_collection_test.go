@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func TestAccHarborGarbageCollectionUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborGarbageCollection("weekly", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_garbage_collection.gc", "schedule", "weekly"),
+					resource.TestCheckResourceAttr("harbor_garbage_collection.gc", "delete_untagged", "false"),
+				),
+			},
+			{
+				Config: testHarborGarbageCollection("daily", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_garbage_collection.gc", "schedule", "daily"),
+					resource.TestCheckResourceAttr("harbor_garbage_collection.gc", "delete_untagged", "true"),
+				),
+			},
+			{
+				ResourceName:      "harbor_garbage_collection.gc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testHarborGarbageCollection(schedule string, deleteUntagged bool) string {
+	return fmt.Sprintf(`
+resource "harbor_garbage_collection" "gc" {
+	schedule        = "%s"
+	delete_untagged = %t
+}
+	`, schedule, deleteUntagged)
+}
+
+func TestMapGCScheduleToData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceGarbageCollection().Schema, map[string]interface{}{"schedule": "weekly"})
+
+	err := mapGCScheduleToData(d, &harbor.GCHistory{
+		JobParameters: `{"delete_untagged":true,"dry_run":false,"workers":3,"redis_url_reg":"redis://redis:6379/1"}`,
+		Schedule:      &harbor.ScheduleObj{Type: harbor.ScheduleTypeDaily, Cron: "0 0 0 * * *"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if d.Get("schedule").(string) != "daily" {
+		t.Errorf("expected schedule daily, got %s", d.Get("schedule"))
+	}
+	if !d.Get("delete_untagged").(bool) {
+		t.Errorf("expected delete_untagged to be read from the job parameters")
+	}
+	if d.Get("workers").(int) != 3 {
+		t.Errorf("expected workers 3, got %d", d.Get("workers"))
+	}
+}
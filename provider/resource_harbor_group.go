@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var groupTypes = map[string]int{
+	"ldap": harbor.UserGroupTypeLDAP,
+	"http": harbor.UserGroupTypeHTTP,
+	"oidc": harbor.UserGroupTypeOIDC,
+}
+
+func resourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupCreate,
+		Read:   resourceGroupRead,
+		Update: resourceGroupUpdate,
+		Delete: resourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the group.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"group_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of the group, one of 'ldap', 'http' or 'oidc'.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ldap", "http", "oidc"}, false),
+			},
+			"ldap_group_dn": {
+				Type:        schema.TypeString,
+				Description: "DN of the LDAP group. Required when group_type is 'ldap'.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "Numeric ID of the group, as used in project membership.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func mapDataToGroup(d *schema.ResourceData, group *harbor.UserGroup) error {
+	group.GroupName = d.Get("group_name").(string)
+	group.GroupType = groupTypes[d.Get("group_type").(string)]
+	group.LdapGroupDN = d.Get("ldap_group_dn").(string)
+
+	if group.GroupType == harbor.UserGroupTypeLDAP && group.LdapGroupDN == "" {
+		return fmt.Errorf("ldap_group_dn must be set for groups with group_type 'ldap'")
+	}
+	return nil
+}
+
+func mapGroupToData(d *schema.ResourceData, group *harbor.UserGroup) error {
+	err := d.Set("group_name", group.GroupName)
+	if err != nil {
+		return err
+	}
+	for name, groupType := range groupTypes {
+		if groupType == group.GroupType {
+			err = d.Set("group_type", name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = d.Set("ldap_group_dn", group.LdapGroupDN)
+	if err != nil {
+		return err
+	}
+	err = d.Set("group_id", group.ID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	group, err := client.GetUserGroup(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	return mapGroupToData(d, group)
+}
+
+func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	group := &harbor.UserGroup{}
+	err := mapDataToGroup(d, group)
+	if err != nil {
+		return err
+	}
+
+	location, err := client.NewUserGroup(group)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(location)
+	return resourceGroupRead(d, meta)
+}
+
+func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	group := &harbor.UserGroup{}
+	err := mapDataToGroup(d, group)
+	if err != nil {
+		return err
+	}
+
+	err = client.UpdateUserGroup(d.Id(), group)
+	if err != nil {
+		return err
+	}
+
+	return resourceGroupRead(d, meta)
+}
+
+func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.DeleteUserGroup(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+	return nil
+}
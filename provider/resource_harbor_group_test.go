@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborGroupUpdate(t *testing.T) {
+	t.Parallel()
+
+	groupName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_group"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborGroupBasic(groupName, "http"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_group.group"),
+					resource.TestCheckResourceAttr("harbor_group.group", "group_type", "http"),
+				),
+			},
+			{
+				Config: testHarborGroupBasic(groupName+"-renamed", "http"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_group.group"),
+					resource.TestCheckResourceAttr("harbor_group.group", "group_name", groupName+"-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHarborGroupLdapRequiresDN(t *testing.T) {
+	t.Parallel()
+
+	groupName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_group"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborGroupBasic(groupName, "ldap"),
+				ExpectError: regexp.MustCompile("ldap_group_dn must be set"),
+			},
+		},
+	})
+}
+
+func testHarborGroupBasic(name string, groupType string) string {
+	return fmt.Sprintf(`
+resource "harbor_group" "group" {
+	group_name = "%s"
+	group_type = "%s"
+}
+	`, name, groupType)
+}
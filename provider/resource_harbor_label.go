@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -18,7 +19,7 @@ func resourceLabel() *schema.Resource {
 		Update: resourceLabelUpdate,
 		Delete: resourceLabelDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceLabelImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +94,69 @@ func mapLabelToData(d *schema.ResourceData, label *harbor.Label) error {
 	return nil
 }
 
+// findLabel looks up a label by its exact name. A projectPath of the form
+// '/projects/${ID_NUMBER}' searches project labels, otherwise global labels
+// are searched.
+func findLabel(client *harbor.Client, name string, projectPath string) (*harbor.Label, error) {
+	scope := "g"
+	var projectID int64
+	if projectPath != "" {
+		ID, err := strconv.ParseInt(strings.TrimPrefix(projectPath, "/projects/"), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		projectID = ID
+		scope = "p"
+	}
+
+	labels, err := client.ListLabels(scope, projectID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, label := range labels {
+		if label.Name == name {
+			return label, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no label named %s found", name)
+}
+
+// resourceLabelImport accepts either a label ID of the form '/labels/${ID_NUMBER}',
+// 'global/${LABEL_NAME}' for global labels, or '${PROJECT_NAME}/${LABEL_NAME}'
+// for project labels.
+func resourceLabelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*harbor.Client)
+	importID := d.Id()
+
+	if strings.HasPrefix(importID, "/labels/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	parts := strings.SplitN(importID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid label import id %s, expected '/labels/${ID_NUMBER}', 'global/${LABEL_NAME}' or '${PROJECT_NAME}/${LABEL_NAME}'", importID)
+	}
+
+	projectPath := ""
+	if parts[0] != "global" {
+		project, err := client.GetProjectByName(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		projectPath = fmt.Sprintf("/projects/%d", project.ProjectID)
+	}
+
+	label, err := findLabel(client, parts[1], projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("/labels/%d", label.ID))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceLabelRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*harbor.Client)
 	labelID := d.Id()
@@ -162,6 +162,39 @@ func TestAccHarborLabelCreateAfterManualDestroy(t *testing.T) {
 	})
 }
 
+func TestAccHarborLabelImportByName(t *testing.T) {
+	t.Parallel()
+
+	labelName := "terraform-" + acctest.RandString(10)
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_label"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborLabelBasic(labelName),
+			},
+			{
+				ResourceName:      "harbor_label.label",
+				ImportStateId:     "global/" + labelName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testHarborLabelWithProject(projectName, labelName),
+			},
+			{
+				ResourceName:      "harbor_label.label",
+				ImportStateId:     projectName + "/" + labelName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testHarborLabelBasic(name string) string {
 	return fmt.Sprintf(`
 resource "harbor_label" "label" {
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var preheatAuthModes = map[string]string{
+	"none":  "NONE",
+	"basic": "BASIC",
+	"oauth": "OAUTH",
+}
+
+func resourcePreheatInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePreheatInstanceCreate,
+		Read:   resourcePreheatInstanceRead,
+		Update: resourcePreheatInstanceUpdate,
+		Delete: resourcePreheatInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Display name of the preheat instance.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the preheat instance.",
+				Optional:    true,
+			},
+			"vendor": {
+				Type:         schema.TypeString,
+				Description:  "P2P provider of the instance, either 'dragonfly' or 'kraken'.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"dragonfly", "kraken"}, false),
+			},
+			"endpoint": {
+				Type:         schema.TypeString,
+				Description:  "URL of the P2P provider.",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Description:  "How Harbor authenticates to the P2P provider, one of 'none', 'basic' or 'oauth'.",
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "basic", "oauth"}, false),
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "Username used when auth_mode is 'basic'.",
+				Optional:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password used when auth_mode is 'basic'.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Description: "Token used when auth_mode is 'oauth'.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "When true, the instance is enabled.",
+				Optional:    true,
+				Default:     true,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "When true, the instance is the default preheat instance.",
+				Optional:    true,
+				Default:     false,
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Description: "If true, skips tls certificate verification of the P2P provider.",
+				Optional:    true,
+				Default:     false,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "Numeric ID of the instance, as used by preheat policies.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func mapDataToPreheatInstance(d *schema.ResourceData, instance *harbor.PreheatInstance) {
+	instance.Name = d.Get("name").(string)
+	instance.Description = d.Get("description").(string)
+	instance.Vendor = d.Get("vendor").(string)
+	instance.Endpoint = d.Get("endpoint").(string)
+	instance.AuthMode = preheatAuthModes[d.Get("auth_mode").(string)]
+	instance.Enabled = d.Get("enabled").(bool)
+	instance.Default = d.Get("default").(bool)
+	instance.Insecure = d.Get("insecure").(bool)
+
+	switch instance.AuthMode {
+	case "BASIC":
+		instance.AuthInfo = map[string]string{
+			"username": d.Get("username").(string),
+			"password": d.Get("password").(string),
+		}
+	case "OAUTH":
+		instance.AuthInfo = map[string]string{
+			"token": d.Get("token").(string),
+		}
+	}
+}
+
+func mapPreheatInstanceToData(d *schema.ResourceData, instance *harbor.PreheatInstance) error {
+	err := d.Set("name", instance.Name)
+	if err != nil {
+		return err
+	}
+	err = d.Set("description", instance.Description)
+	if err != nil {
+		return err
+	}
+	err = d.Set("vendor", instance.Vendor)
+	if err != nil {
+		return err
+	}
+	err = d.Set("endpoint", instance.Endpoint)
+	if err != nil {
+		return err
+	}
+	for name, authMode := range preheatAuthModes {
+		if authMode == instance.AuthMode {
+			err = d.Set("auth_mode", name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = d.Set("enabled", instance.Enabled)
+	if err != nil {
+		return err
+	}
+	err = d.Set("default", instance.Default)
+	if err != nil {
+		return err
+	}
+	err = d.Set("insecure", instance.Insecure)
+	if err != nil {
+		return err
+	}
+	err = d.Set("instance_id", instance.ID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourcePreheatInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	instance, err := client.GetPreheatInstance(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	return mapPreheatInstanceToData(d, instance)
+}
+
+func resourcePreheatInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	instance := &harbor.PreheatInstance{}
+	mapDataToPreheatInstance(d, instance)
+
+	err := client.NewPreheatInstance(instance)
+	if err != nil {
+		return err
+	}
+
+	// instances are addressed by name rather than by the ID in the Location header
+	d.SetId(harbor.PreheatInstanceID(instance.Name))
+	return resourcePreheatInstanceRead(d, meta)
+}
+
+func resourcePreheatInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	instance := &harbor.PreheatInstance{}
+	mapDataToPreheatInstance(d, instance)
+	instance.ID = int64(d.Get("instance_id").(int))
+
+	err := client.UpdatePreheatInstance(d.Id(), instance)
+	if err != nil {
+		return err
+	}
+
+	return resourcePreheatInstanceRead(d, meta)
+}
+
+func resourcePreheatInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.DeletePreheatInstance(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborPreheatInstanceUpdate(t *testing.T) {
+	t.Parallel()
+
+	instanceName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_preheat_instance"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborPreheatInstanceBasic(instanceName, "http://dragonfly.example.com:8002"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_preheat_instance.instance"),
+					resource.TestCheckResourceAttr("harbor_preheat_instance.instance", "vendor", "dragonfly"),
+					resource.TestCheckResourceAttr("harbor_preheat_instance.instance", "auth_mode", "basic"),
+					resource.TestCheckResourceAttrSet("harbor_preheat_instance.instance", "instance_id"),
+				),
+			},
+			{
+				Config: testHarborPreheatInstanceBasic(instanceName, "http://dragonfly.example.com:8003"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_preheat_instance.instance"),
+					resource.TestCheckResourceAttr("harbor_preheat_instance.instance", "endpoint", "http://dragonfly.example.com:8003"),
+				),
+			},
+			{
+				ResourceName:            "harbor_preheat_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"username", "password"},
+			},
+		},
+	})
+}
+
+func testHarborPreheatInstanceBasic(name string, endpoint string) string {
+	return fmt.Sprintf(`
+resource "harbor_preheat_instance" "instance" {
+	name      = "%s"
+	vendor    = "dragonfly"
+	endpoint  = "%s"
+	auth_mode = "basic"
+	username  = "admin"
+	password  = "secret"
+}
+	`, name, endpoint)
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var preheatPolicyIDRegexp = regexp.MustCompile(`^/projects/([^/]+)/preheat/policies/([^/]+)$`)
+
+// preheatSeverities maps the vulnerability_severity attribute to the severity
+// codes Harbor uses in the vulnerability filter of a preheat policy.
+var preheatSeverities = map[string]int{
+	"none":     0,
+	"low":      2,
+	"medium":   3,
+	"high":     4,
+	"critical": 5,
+}
+
+var preheatTriggerTypes = []string{"manual", "scheduled", "event_based"}
+
+func resourcePreheatPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePreheatPolicyCreate,
+		Read:   resourcePreheatPolicyRead,
+		Update: resourcePreheatPolicyUpdate,
+		Delete: resourcePreheatPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePreheatPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project whose artifacts are preheated.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the preheat policy.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the preheat policy.",
+				Optional:    true,
+			},
+			"preheat_instance_id": {
+				Type:        schema.TypeInt,
+				Description: "Numeric ID of the preheat instance artifacts are preheated into, as exported by the instance_id attribute of harbor_preheat_instance.",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "When true, the policy is enabled.",
+				Optional:    true,
+				Default:     true,
+			},
+			"repository_filter": {
+				Type:        schema.TypeString,
+				Description: "Doublestar pattern matching the names of the repositories to preheat, e.g. '**' or '{app,web}/**'.",
+				Optional:    true,
+				Default:     "**",
+			},
+			"tag_filter": {
+				Type:        schema.TypeString,
+				Description: "Doublestar pattern matching the tags of the artifacts to preheat, e.g. '**' or 'v*'.",
+				Optional:    true,
+				Default:     "**",
+			},
+			"label_filter": {
+				Type:        schema.TypeString,
+				Description: "Name of a label the artifacts must have to be preheated.",
+				Optional:    true,
+			},
+			"vulnerability_severity": {
+				Type:         schema.TypeString,
+				Description:  "Artifacts with vulnerabilities of this severity or above aren't preheated, one of 'none', 'low', 'medium', 'high' or 'critical'.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "low", "medium", "high", "critical"}, false),
+			},
+			"trigger_type": {
+				Type:         schema.TypeString,
+				Description:  "When artifacts are preheated, one of 'manual', 'scheduled' or 'event_based'.",
+				Optional:     true,
+				Default:      "manual",
+				ValidateFunc: validation.StringInSlice(preheatTriggerTypes, false),
+			},
+			"cron": {
+				Type:         schema.TypeString,
+				Description:  "Cron expression with six fields, starting with seconds, used when trigger_type is 'scheduled'.",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\S+( \S+){5}$`), "validation error: cron should have six fields, e.g. '0 0 2 * * *'"),
+			},
+		},
+	}
+}
+
+func mapDataToPreheatPolicy(d *schema.ResourceData, policy *harbor.PreheatPolicy) error {
+	policy.Name = d.Get("name").(string)
+	policy.Description = d.Get("description").(string)
+	policy.ProviderID = int64(d.Get("preheat_instance_id").(int))
+	policy.Enabled = d.Get("enabled").(bool)
+
+	filters := []harbor.PreheatFilter{
+		{Type: "repository", Value: d.Get("repository_filter").(string)},
+		{Type: "tag", Value: d.Get("tag_filter").(string)},
+	}
+	if label := d.Get("label_filter").(string); label != "" {
+		filters = append(filters, harbor.PreheatFilter{Type: "label", Value: label})
+	}
+	if severity := d.Get("vulnerability_severity").(string); severity != "" {
+		filters = append(filters, harbor.PreheatFilter{Type: "vulnerability", Value: preheatSeverities[severity]})
+	}
+	filtersJSON, err := json.Marshal(filters)
+	if err != nil {
+		return err
+	}
+	policy.Filters = string(filtersJSON)
+
+	trigger := harbor.PreheatTrigger{Type: d.Get("trigger_type").(string)}
+	if trigger.Type == "scheduled" {
+		cron := d.Get("cron").(string)
+		if cron == "" {
+			return fmt.Errorf("cron must be set when trigger_type is 'scheduled'")
+		}
+		trigger.TriggerSetting = &harbor.PreheatTriggerSetting{Cron: cron}
+	}
+	triggerJSON, err := json.Marshal(trigger)
+	if err != nil {
+		return err
+	}
+	policy.Trigger = string(triggerJSON)
+
+	return nil
+}
+
+func mapPreheatPolicyToData(d *schema.ResourceData, policy *harbor.PreheatPolicy) error {
+	err := d.Set("name", policy.Name)
+	if err != nil {
+		return err
+	}
+	err = d.Set("description", policy.Description)
+	if err != nil {
+		return err
+	}
+	err = d.Set("preheat_instance_id", policy.ProviderID)
+	if err != nil {
+		return err
+	}
+	err = d.Set("enabled", policy.Enabled)
+	if err != nil {
+		return err
+	}
+
+	var filters []harbor.PreheatFilter
+	if policy.Filters != "" {
+		err = json.Unmarshal([]byte(policy.Filters), &filters)
+		if err != nil {
+			return err
+		}
+	}
+	values := map[string]string{
+		"repository_filter":      "",
+		"tag_filter":             "",
+		"label_filter":           "",
+		"vulnerability_severity": "",
+	}
+	for _, filter := range filters {
+		switch filter.Type {
+		case "repository", "tag", "label":
+			if value, ok := filter.Value.(string); ok {
+				values[filter.Type+"_filter"] = value
+			}
+		case "vulnerability":
+			for name, code := range preheatSeverities {
+				if number, ok := filter.Value.(float64); ok && int(number) == code {
+					values["vulnerability_severity"] = name
+				}
+			}
+		}
+	}
+	for attribute, value := range values {
+		err = d.Set(attribute, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	trigger := harbor.PreheatTrigger{}
+	if policy.Trigger != "" {
+		err = json.Unmarshal([]byte(policy.Trigger), &trigger)
+		if err != nil {
+			return err
+		}
+	}
+	err = d.Set("trigger_type", trigger.Type)
+	if err != nil {
+		return err
+	}
+	cron := ""
+	if trigger.TriggerSetting != nil {
+		cron = trigger.TriggerSetting.Cron
+	}
+	err = d.Set("cron", cron)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourcePreheatPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	matches := preheatPolicyIDRegexp.FindStringSubmatch(d.Id())
+	if matches == nil {
+		return nil, fmt.Errorf("invalid preheat policy id %s, expected the form '/projects/${PROJECT_NAME}/preheat/policies/${POLICY_NAME}'", d.Id())
+	}
+
+	err := d.Set("project_name", matches[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourcePreheatPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	policy, err := client.GetPreheatPolicy(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	return mapPreheatPolicyToData(d, policy)
+}
+
+func resourcePreheatPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+
+	project, err := client.GetProjectByName(projectName)
+	if err != nil {
+		return err
+	}
+
+	policy := &harbor.PreheatPolicy{ProjectID: int64(project.ProjectID)}
+	err = mapDataToPreheatPolicy(d, policy)
+	if err != nil {
+		return err
+	}
+
+	err = client.NewPreheatPolicy(projectName, policy)
+	if err != nil {
+		return err
+	}
+
+	// policies are addressed by name rather than by the ID in the Location header
+	d.SetId(harbor.PreheatPolicyID(projectName, policy.Name))
+	return resourcePreheatPolicyRead(d, meta)
+}
+
+func resourcePreheatPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	policy, err := client.GetPreheatPolicy(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = mapDataToPreheatPolicy(d, policy)
+	if err != nil {
+		return err
+	}
+
+	err = client.UpdatePreheatPolicy(d.Id(), policy)
+	if err != nil {
+		return err
+	}
+
+	return resourcePreheatPolicyRead(d, meta)
+}
+
+func resourcePreheatPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.DeletePreheatPolicy(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborPreheatPolicyUpdate(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	instanceName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_preheat_policy"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborPreheatPolicyBasic(projectName, instanceName, `trigger_type = "manual"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_preheat_policy.policy"),
+					resource.TestCheckResourceAttrPair("harbor_preheat_policy.policy", "preheat_instance_id", "harbor_preheat_instance.instance", "instance_id"),
+					resource.TestCheckResourceAttr("harbor_preheat_policy.policy", "tag_filter", "v*"),
+					resource.TestCheckResourceAttr("harbor_preheat_policy.policy", "vulnerability_severity", "high"),
+				),
+			},
+			{
+				Config: testHarborPreheatPolicyBasic(projectName, instanceName, `
+	trigger_type = "scheduled"
+	cron         = "0 0 2 * * *"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_preheat_policy.policy"),
+					resource.TestCheckResourceAttr("harbor_preheat_policy.policy", "trigger_type", "scheduled"),
+					resource.TestCheckResourceAttr("harbor_preheat_policy.policy", "cron", "0 0 2 * * *"),
+				),
+			},
+			{
+				ResourceName:      "harbor_preheat_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testHarborPreheatPolicyBasic(projectName string, instanceName string, trigger string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+resource "harbor_preheat_instance" "instance" {
+	name     = "%s"
+	vendor   = "dragonfly"
+	endpoint = "http://dragonfly.example.com:8002"
+}
+
+resource "harbor_preheat_policy" "policy" {
+	project_name           = harbor_project.project.name
+	name                   = "policy"
+	preheat_instance_id    = harbor_preheat_instance.instance.instance_id
+	tag_filter             = "v*"
+	vulnerability_severity = "high"
+	%s
+}
+	`, projectName, instanceName, trigger)
+}
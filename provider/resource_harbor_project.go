@@ -1,7 +1,11 @@
 package provider
 
 import (
+	"fmt"
+	"log"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,6 +48,39 @@ func resourceProject() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"enforce_notary_signature": {
+				Type:        schema.TypeBool,
+				Description: "When true, only artifacts signed with Notary (content trust) can be pulled.",
+				Optional:    true,
+				Default:     false,
+			},
+			"enforce_cosign_signature": {
+				Type:        schema.TypeBool,
+				Description: "When true, only artifacts signed with cosign can be pulled. Requires Harbor 2.5 or later.",
+				Optional:    true,
+				Default:     false,
+			},
+			"scanner_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the scanner used by the project, in the form '/scanners/${UUID}'. If not set, the system default scanner is used.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/scanners/[0-9a-f-]+$`), "validation error: scanner_id should be of the form '/scanners/${UUID}'"),
+			},
+			"registry_id": {
+				Type:         schema.TypeInt,
+				Description:  "If set, the ID of the registry endpoint the project is a proxy cache of.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"proxy_speed_kb": {
+				Type:         schema.TypeInt,
+				Description:  "Bandwidth limit of a proxy cache project pulling from its registry, in KB/s, or -1 for no limit.",
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.Any(validation.IntAtLeast(1), validation.IntInSlice([]int{-1})),
+			},
 		},
 	}
 }
@@ -52,8 +89,16 @@ func mapDataToProjectReq(d *schema.ResourceData, project *harbor.ProjectReq) err
 	project.ProjectName = d.Get("name").(string)
 
 	project.Metadata = harbor.ProjectMetadata{
-		Public:   d.Get("public").(bool),
-		AutoScan: d.Get("auto_scan").(bool),
+		Public:                   d.Get("public").(bool),
+		AutoScan:                 d.Get("auto_scan").(bool),
+		EnableContentTrust:       d.Get("enforce_notary_signature").(bool),
+		EnableContentTrustCosign: d.Get("enforce_cosign_signature").(bool),
+	}
+
+	if registryID, ok := d.GetOk("registry_id"); ok {
+		ID := int64(registryID.(int))
+		project.RegistryID = &ID
+		project.Metadata.ProxySpeedKB = strconv.Itoa(d.Get("proxy_speed_kb").(int))
 	}
 	return nil
 }
@@ -71,6 +116,43 @@ func mapProjectToData(d *schema.ResourceData, project *harbor.Project) error {
 	if err != nil {
 		return err
 	}
+	err = d.Set("enforce_notary_signature", project.Metadata.EnableContentTrust)
+	if err != nil {
+		return err
+	}
+	err = d.Set("enforce_cosign_signature", project.Metadata.EnableContentTrustCosign)
+	if err != nil {
+		return err
+	}
+	err = d.Set("registry_id", project.RegistryID)
+	if err != nil {
+		return err
+	}
+	proxySpeedKB := -1
+	if project.Metadata.ProxySpeedKB != "" {
+		proxySpeedKB, err = strconv.Atoi(project.Metadata.ProxySpeedKB)
+		if err != nil {
+			return err
+		}
+	}
+	err = d.Set("proxy_speed_kb", proxySpeedKB)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkProxyCacheRegistry ensures the registry endpoint of a proxy cache
+// project is of a type Harbor can proxy.
+func checkProxyCacheRegistry(client *harbor.Client, registryID int64) error {
+	registry, err := client.GetRegistry(registryID)
+	if err != nil {
+		return err
+	}
+
+	if !registry.SupportsProxyCache() {
+		return fmt.Errorf("registry %s of type %s can't be used as a proxy cache", registry.Name, registry.Type)
+	}
 	return nil
 }
 
@@ -82,7 +164,33 @@ func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
 		return handleNotFoundError(err, d)
 	}
 
-	return mapProjectToData(d, project)
+	err = mapProjectToData(d, project)
+	if err != nil {
+		return err
+	}
+
+	scanner, err := client.GetProjectScanner(projectID)
+	// this can return a 404 if no scanner is registered at all
+	if err != nil && !harbor.ErrorIs404(err) {
+		return err
+	}
+	if scanner != nil {
+		err = d.Set("scanner_id", "/scanners/"+scanner.UUID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateProjectScanner(d *schema.ResourceData, client *harbor.Client) error {
+	scannerID, ok := d.GetOk("scanner_id")
+	if !ok || !d.HasChange("scanner_id") {
+		return nil
+	}
+
+	return client.SetProjectScanner(d.Id(), strings.TrimPrefix(scannerID.(string), "/scanners/"))
 }
 
 func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
@@ -94,12 +202,25 @@ func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if project.RegistryID != nil {
+		err = checkProxyCacheRegistry(client, *project.RegistryID)
+		if err != nil {
+			return err
+		}
+	}
+
 	location, err := client.NewProject(project)
 	if err != nil {
 		return err
 	}
 
 	d.SetId(location)
+
+	err = updateProjectScanner(d, client)
+	if err != nil {
+		return err
+	}
+
 	return resourceProjectRead(d, meta)
 }
 
@@ -112,11 +233,19 @@ func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// the registry of a proxy cache project can't be changed
+	project.RegistryID = nil
+
 	err = client.UpdateProject(d.Id(), project)
 	if err != nil {
 		return err
 	}
 
+	err = updateProjectScanner(d, client)
+	if err != nil {
+		return err
+	}
+
 	return resourceProjectRead(d, meta)
 }
 
@@ -124,7 +253,7 @@ func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*harbor.Client)
 	projectName := d.Get("name").(string)
 
-	repos, err := client.GetRepositories(projectName)
+	repos, err := client.GetRepositories(projectName, "")
 	if err != nil {
 		return handleNotFoundError(err, d)
 	}
@@ -136,17 +265,26 @@ func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	charts, err := client.GetCharts(projectName)
-	// this can return a 404 if chartmuseum is disabled
-	if err != nil && !harbor.ErrorIs404(err) {
-		return err
+	// chartmuseum can be disabled, and was removed entirely in Harbor 2.8
+	systemInfo, err := client.GetSystemInfo()
+	if err != nil {
+		log.Printf("[WARN] Unable to get system info, skipping the chart repository of project %s: %s", projectName, err)
+		systemInfo = &harbor.SystemInfo{}
 	}
 
-	if len(charts) > 0 {
-		err = client.DeleteCharts(projectName, charts)
-		if err != nil {
+	if systemInfo.WithChartmuseum {
+		charts, err := client.GetCharts(projectName)
+		// this can still return a 404 if the project has no chart repository
+		if err != nil && !harbor.ErrorIs404(err) {
 			return err
 		}
+
+		if len(charts) > 0 {
+			err = client.DeleteCharts(projectName, charts)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	err = client.DeleteProject(d.Id())
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var storageUnits = map[string]int64{
+	"B":  1,
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+	"TB": 1 << 40,
+}
+
+func resourceProjectQuota() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectQuotaCreate,
+		Read:   resourceProjectQuotaRead,
+		Update: resourceProjectQuotaUpdate,
+		Delete: resourceProjectQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the project the quota applies to, in the form '/projects/${ID_NUMBER}'",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/projects/[0-9]+$`), "validation error: project_id should be of the form '/projects/${ID_NUMBER}'"),
+			},
+			"storage_limit": {
+				Type:         schema.TypeInt,
+				Description:  "Storage limit of the project in storage_unit, or -1 for unlimited storage.",
+				Required:     true,
+				ValidateFunc: validation.Any(validation.IntAtLeast(1), validation.IntInSlice([]int{-1})),
+			},
+			"storage_unit": {
+				Type:         schema.TypeString,
+				Description:  "Unit of storage_limit, one of 'B', 'KB', 'MB', 'GB' or 'TB', each 1024 times the previous.",
+				Optional:     true,
+				Default:      "GB",
+				ValidateFunc: validation.StringInSlice([]string{"B", "KB", "MB", "GB", "TB"}, false),
+			},
+			"count_limit": {
+				Type:         schema.TypeInt,
+				Description:  "Artifact count limit of the project, or -1 for no limit. Only supported by Harbor 2.0.",
+				Optional:     true,
+				ValidateFunc: validation.Any(validation.IntAtLeast(1), validation.IntInSlice([]int{-1})),
+			},
+			"storage_used": {
+				Type:        schema.TypeInt,
+				Description: "Storage used by the project, in bytes.",
+				Computed:    true,
+			},
+			"count_used": {
+				Type:        schema.TypeInt,
+				Description: "Number of artifacts in the project. Only reported by Harbor 2.0.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// largestStorageUnit returns the largest unit the given number of bytes is a whole multiple of.
+func largestStorageUnit(bytes int64) string {
+	for _, unit := range []string{"TB", "GB", "MB", "KB"} {
+		if bytes%storageUnits[unit] == 0 {
+			return unit
+		}
+	}
+	return "B"
+}
+
+func mapDataToQuotaUpdateReq(d *schema.ResourceData, quota *harbor.QuotaUpdateReq) {
+	storageLimit := int64(d.Get("storage_limit").(int))
+	if storageLimit > 0 {
+		storageLimit *= storageUnits[d.Get("storage_unit").(string)]
+	}
+
+	quota.Hard = map[string]int64{
+		"storage": storageLimit,
+	}
+	if countLimit, ok := d.GetOk("count_limit"); ok {
+		quota.Hard["count"] = int64(countLimit.(int))
+	}
+}
+
+func mapQuotaToData(d *schema.ResourceData, quota *harbor.Quota) error {
+	if quota.Ref != nil {
+		err := d.Set("project_id", fmt.Sprintf("/projects/%d", quota.Ref.ID))
+		if err != nil {
+			return err
+		}
+	}
+
+	storageLimit := quota.Hard["storage"]
+	if storageLimit > 0 {
+		unit := d.Get("storage_unit").(string)
+		// pick another unit when the limit was set to a size not expressible in
+		// the configured unit, or when no unit is configured yet after an import
+		if _, ok := storageUnits[unit]; !ok || storageLimit%storageUnits[unit] != 0 {
+			unit = largestStorageUnit(storageLimit)
+		}
+		err := d.Set("storage_unit", unit)
+		if err != nil {
+			return err
+		}
+		storageLimit /= storageUnits[unit]
+	}
+	err := d.Set("storage_limit", storageLimit)
+	if err != nil {
+		return err
+	}
+	if countLimit, ok := quota.Hard["count"]; ok {
+		err = d.Set("count_limit", countLimit)
+		if err != nil {
+			return err
+		}
+	}
+	err = d.Set("storage_used", quota.Used["storage"])
+	if err != nil {
+		return err
+	}
+	err = d.Set("count_used", quota.Used["count"])
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceProjectQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	quota, err := client.GetQuota(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	return mapQuotaToData(d, quota)
+}
+
+func resourceProjectQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	// every project has a quota, so creating this resource adopts it
+	quota, err := client.GetProjectQuota(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("/quotas/%d", quota.ID))
+	return resourceProjectQuotaUpdate(d, meta)
+}
+
+func resourceProjectQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	quota := &harbor.QuotaUpdateReq{}
+	mapDataToQuotaUpdateReq(d, quota)
+
+	err := client.UpdateQuota(d.Id(), quota)
+	if err != nil {
+		return err
+	}
+
+	return resourceProjectQuotaRead(d, meta)
+}
+
+func resourceProjectQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	// the quota is removed along with its project, so destroying this resource lifts the limits
+	quota := &harbor.QuotaUpdateReq{Hard: map[string]int64{"storage": -1}}
+	if _, ok := d.GetOk("count_limit"); ok {
+		quota.Hard["count"] = -1
+	}
+
+	err := client.UpdateQuota(d.Id(), quota)
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborProjectQuotaUpdate(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_project"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborProjectQuota(projectName, 10, "GB"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_project_quota.quota"),
+					resource.TestCheckResourceAttr("harbor_project_quota.quota", "storage_limit", "10"),
+					resource.TestCheckResourceAttr("harbor_project_quota.quota", "storage_used", "0"),
+				),
+			},
+			{
+				Config: testHarborProjectQuota(projectName, 512, "MB"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_project_quota.quota", "storage_limit", "512"),
+					resource.TestCheckResourceAttr("harbor_project_quota.quota", "storage_unit", "MB"),
+				),
+			},
+			{
+				ResourceName:      "harbor_project_quota.quota",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testHarborProjectQuota(projectName, -1, "GB"),
+				Check:  resource.TestCheckResourceAttr("harbor_project_quota.quota", "storage_limit", "-1"),
+			},
+		},
+	})
+}
+
+func testHarborProjectQuota(projectName string, storageLimit int, storageUnit string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+resource "harbor_project_quota" "quota" {
+	project_id    = harbor_project.project.id
+	storage_limit = %d
+	storage_unit  = "%s"
+}
+	`, projectName, storageLimit, storageUnit)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -76,6 +77,36 @@ func TestAccHarborProjectUpdate(t *testing.T) {
 	})
 }
 
+func TestAccHarborProjectSignatureEnforcement(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_project"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborProjectSignatureEnforcement(projectName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_project.project"),
+					resource.TestCheckResourceAttr("harbor_project.project", "enforce_notary_signature", "true"),
+					resource.TestCheckResourceAttr("harbor_project.project", "enforce_cosign_signature", "false"),
+				),
+			},
+			{
+				Config: testHarborProjectSignatureEnforcement(projectName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_project.project"),
+					resource.TestCheckResourceAttr("harbor_project.project", "enforce_notary_signature", "false"),
+					resource.TestCheckResourceAttr("harbor_project.project", "enforce_cosign_signature", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccHarborProjectCreateAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 
@@ -147,6 +178,24 @@ func TestAccHarborProjectImportAfterManualCreate(t *testing.T) {
 	}
 }
 
+func TestAccHarborProjectProxyCacheMissingRegistry(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_project"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborProjectProxyCache(projectName, 999999),
+				ExpectError: regexp.MustCompile("404 Not Found"),
+			},
+		},
+	})
+}
+
 func testHarborProjectBasic(projectName string) string {
 	return fmt.Sprintf(`
 resource "harbor_project" "project" {
@@ -164,3 +213,23 @@ resource "harbor_project" "project" {
 }
 	`, projectName, public, autoScan)
 }
+
+func testHarborProjectSignatureEnforcement(projectName string, notary bool, cosign bool) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name                     = "%s"
+	enforce_notary_signature = %t
+	enforce_cosign_signature = %t
+}
+	`, projectName, notary, cosign)
+}
+
+func testHarborProjectProxyCache(projectName string, registryID int) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name           = "%s"
+	registry_id    = %d
+	proxy_speed_kb = 2048
+}
+	`, projectName, registryID)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func resourcePurgeAuditLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePurgeAuditLogUpdate,
+		Read:   resourcePurgeAuditLogRead,
+		Update: resourcePurgeAuditLogUpdate,
+		Delete: resourcePurgeAuditLogDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: scheduleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"schedule": scheduleTypeSchema(),
+			"cron":     scheduleCronSchema(),
+			"retention_hours": {
+				Type:         schema.TypeInt,
+				Description:  "Audit logs older than this many hours are purged.",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"include_operations": {
+				Type:        schema.TypeSet,
+				Description: "Operations whose audit logs are purged, any of 'create', 'delete' and 'pull'.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"create", "delete", "pull"}, false),
+				},
+			},
+		},
+	}
+}
+
+func mapDataToPurgeAuditSchedule(d *schema.ResourceData, schedule *harbor.Schedule) error {
+	err := mapDataToSchedule(d, schedule)
+	if err != nil {
+		return err
+	}
+
+	operations := []string{}
+	for _, operation := range d.Get("include_operations").(*schema.Set).List() {
+		operations = append(operations, operation.(string))
+	}
+	sort.Strings(operations)
+
+	schedule.Parameters = map[string]interface{}{
+		"audit_retention_hour": d.Get("retention_hours").(int),
+		"include_operations":   strings.Join(operations, ","),
+		"dry_run":              false,
+	}
+	return nil
+}
+
+// purgeAuditParameters are the job parameters of an audit log purge schedule.
+type purgeAuditParameters struct {
+	AuditRetentionHour *int   `json:"audit_retention_hour"`
+	IncludeOperations  string `json:"include_operations"`
+}
+
+func mapPurgeAuditScheduleToData(d *schema.ResourceData, purgeSchedule *harbor.ExecHistory) error {
+	schedule := &harbor.Schedule{}
+	if purgeSchedule != nil {
+		schedule.Schedule = purgeSchedule.Schedule
+	}
+	err := mapScheduleToData(d, schedule)
+	if err != nil {
+		return err
+	}
+	if purgeSchedule == nil || purgeSchedule.JobParameters == "" {
+		return nil
+	}
+
+	var parameters purgeAuditParameters
+	err = json.Unmarshal([]byte(purgeSchedule.JobParameters), &parameters)
+	if err != nil {
+		return fmt.Errorf("invalid audit log purge parameters %s: %s", purgeSchedule.JobParameters, err)
+	}
+
+	if parameters.AuditRetentionHour != nil {
+		err = d.Set("retention_hours", *parameters.AuditRetentionHour)
+		if err != nil {
+			return err
+		}
+	}
+	if parameters.IncludeOperations != "" {
+		err = d.Set("include_operations", strings.Split(parameters.IncludeOperations, ","))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourcePurgeAuditLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	schedule, err := client.GetPurgeAuditSchedule()
+	if err != nil {
+		return err
+	}
+
+	return mapPurgeAuditScheduleToData(d, schedule)
+}
+
+func resourcePurgeAuditLogUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	schedule := &harbor.Schedule{}
+	err := mapDataToPurgeAuditSchedule(d, schedule)
+	if err != nil {
+		return err
+	}
+
+	err = client.SetPurgeAuditSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("/system/purgeaudit/schedule")
+	return resourcePurgeAuditLogRead(d, meta)
+}
+
+func resourcePurgeAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.SetPurgeAuditSchedule(&harbor.Schedule{Schedule: &harbor.ScheduleObj{Type: harbor.ScheduleTypeNone}})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
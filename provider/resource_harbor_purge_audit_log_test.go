@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func TestAccHarborPurgeAuditLogUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborPurgeAuditLog(168, `"create", "delete", "pull"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_purge_audit_log.purge", "retention_hours", "168"),
+					resource.TestCheckResourceAttr("harbor_purge_audit_log.purge", "include_operations.#", "3"),
+				),
+			},
+			{
+				Config: testHarborPurgeAuditLog(720, `"pull"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_purge_audit_log.purge", "retention_hours", "720"),
+					resource.TestCheckResourceAttr("harbor_purge_audit_log.purge", "include_operations.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "harbor_purge_audit_log.purge",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testHarborPurgeAuditLog(retentionHours int, includeOperations string) string {
+	return fmt.Sprintf(`
+resource "harbor_purge_audit_log" "purge" {
+	schedule           = "daily"
+	retention_hours    = %d
+	include_operations = [%s]
+}
+	`, retentionHours, includeOperations)
+}
+
+func TestMapPurgeAuditScheduleToData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePurgeAuditLog().Schema, map[string]interface{}{
+		"schedule":           "weekly",
+		"retention_hours":    168,
+		"include_operations": []interface{}{"pull"},
+	})
+
+	err := mapPurgeAuditScheduleToData(d, &harbor.ExecHistory{
+		JobParameters: `{"audit_retention_hour":720,"dry_run":false,"include_operations":"create,delete"}`,
+		Schedule:      &harbor.ScheduleObj{Type: harbor.ScheduleTypeDaily, Cron: "0 0 0 * * *"},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if d.Get("schedule").(string) != "daily" {
+		t.Errorf("expected schedule daily, got %s", d.Get("schedule"))
+	}
+	if d.Get("retention_hours").(int) != 720 {
+		t.Errorf("expected retention_hours 720, got %d", d.Get("retention_hours"))
+	}
+	operations := d.Get("include_operations").(*schema.Set)
+	if operations.Len() != 2 || !operations.Contains("create") || !operations.Contains("delete") {
+		t.Errorf("expected include_operations create and delete, got %v", operations.List())
+	}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var repositoryIDRegexp = regexp.MustCompile(`^/projects/([^/]+)/repositories/([^/]+)$`)
+
+func resourceRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRepositoryCreate,
+		Read:   resourceRepositoryRead,
+		Update: resourceRepositoryUpdate,
+		Delete: resourceRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRepositoryImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the repository belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the repository, without the project prefix.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the repository.",
+				Optional:    true,
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "When true, the repository and all of its artifacts are deleted when the resource is destroyed.",
+				Optional:    true,
+				Default:     false,
+			},
+			"artifact_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of artifacts in the repository.",
+				Computed:    true,
+			},
+			"pull_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of pulls of the repository.",
+				Computed:    true,
+			},
+			"pushed": {
+				Type:        schema.TypeBool,
+				Description: "Whether the repository exists, which it does once an artifact has been pushed to it.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func mapDataToRepositoryReq(d *schema.ResourceData, repository *harbor.RepositoryReq) {
+	repository.Description = d.Get("description").(string)
+}
+
+func mapRepositoryToData(d *schema.ResourceData, repository *harbor.Repository) error {
+	err := d.Set("description", repository.Description)
+	if err != nil {
+		return err
+	}
+	err = d.Set("artifact_count", repository.ArtifactCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("pull_count", repository.PullCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("pushed", true)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func markRepositoryNotPushed(d *schema.ResourceData) error {
+	err := d.Set("artifact_count", 0)
+	if err != nil {
+		return err
+	}
+	err = d.Set("pull_count", 0)
+	if err != nil {
+		return err
+	}
+	err = d.Set("pushed", false)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceRepositoryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	matches := repositoryIDRegexp.FindStringSubmatch(d.Id())
+	if matches == nil {
+		return nil, fmt.Errorf("invalid repository id %s, expected the form '/projects/${PROJECT_NAME}/repositories/${REPOSITORY_NAME}'", d.Id())
+	}
+
+	repoName, err := unescapeRepositoryName(matches[2])
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.Set("project_name", matches[1])
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("name", repoName)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(harbor.RepositoryID(matches[1], repoName))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	repository, err := client.GetRepository(d.Get("project_name").(string), d.Get("name").(string))
+	if harbor.ErrorIs404(err) {
+		// repositories only exist once an artifact has been pushed, so keep the
+		// planned description in state; once the repository appears its actual
+		// description is read, and the next apply sets it
+		log.Printf("[WARN] Repository with id %s does not exist yet", d.Id())
+		return markRepositoryNotPushed(d)
+	}
+	if err != nil {
+		return err
+	}
+
+	return mapRepositoryToData(d, repository)
+}
+
+func resourceRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(harbor.RepositoryID(d.Get("project_name").(string), d.Get("name").(string)))
+
+	return resourceRepositoryUpdate(d, meta)
+}
+
+func resourceRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	repository := &harbor.RepositoryReq{}
+	mapDataToRepositoryReq(d, repository)
+
+	err := client.UpdateRepository(d.Get("project_name").(string), d.Get("name").(string), repository)
+	if err != nil && !harbor.ErrorIs404(err) {
+		return err
+	}
+
+	return resourceRepositoryRead(d, meta)
+}
+
+func resourceRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	if d.Get("delete_on_destroy").(bool) {
+		err := client.DeleteRepository(d.Get("project_name").(string), d.Get("name").(string))
+		if err != nil {
+			return handleNotFoundError(err, d)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
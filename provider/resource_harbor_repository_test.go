@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborRepositoryNotYetPushed(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	repositoryName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_repository"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborRepositoryBasic(projectName, repositoryName, "Test Description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_repository.repository", "id", fmt.Sprintf("/projects/%s/repositories/%s", projectName, repositoryName)),
+					resource.TestCheckResourceAttr("harbor_repository.repository", "artifact_count", "0"),
+					resource.TestCheckResourceAttr("harbor_repository.repository", "pushed", "false"),
+					resource.TestCheckResourceAttr("harbor_repository.repository", "description", "Test Description"),
+				),
+			},
+			{
+				Config: testHarborRepositoryBasic(projectName, repositoryName, "Updated Description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_repository.repository", "pushed", "false"),
+					resource.TestCheckResourceAttr("harbor_repository.repository", "description", "Updated Description"),
+				),
+			},
+		},
+	})
+}
+
+func testHarborRepositoryBasic(projectName string, name string, description string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+resource "harbor_repository" "repository" {
+	project_name      = harbor_project.project.name
+	name              = "%s"
+	description       = "%s"
+	delete_on_destroy = true
+}
+	`, projectName, name, description)
+}
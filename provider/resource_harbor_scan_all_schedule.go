@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func resourceScanAllSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceScanAllScheduleUpdate,
+		Read:   resourceScanAllScheduleRead,
+		Update: resourceScanAllScheduleUpdate,
+		Delete: resourceScanAllScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: scheduleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"schedule": scheduleTypeSchema(),
+			"cron":     scheduleCronSchema(),
+		},
+	}
+}
+
+func resourceScanAllScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	schedule, err := client.GetScanAllSchedule()
+	if err != nil {
+		return err
+	}
+
+	return mapScheduleToData(d, schedule)
+}
+
+func resourceScanAllScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	schedule := &harbor.Schedule{}
+	err := mapDataToSchedule(d, schedule)
+	if err != nil {
+		return err
+	}
+
+	err = client.SetScanAllSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("/system/scanAll/schedule")
+	return resourceScanAllScheduleRead(d, meta)
+}
+
+func resourceScanAllScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.SetScanAllSchedule(&harbor.Schedule{Schedule: &harbor.ScheduleObj{Type: harbor.ScheduleTypeNone}})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
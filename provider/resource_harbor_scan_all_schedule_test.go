@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHarborScanAllScheduleUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborScanAllSchedule("daily", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_scan_all_schedule.schedule", "schedule", "daily"),
+					resource.TestCheckResourceAttr("harbor_scan_all_schedule.schedule", "cron", ""),
+				),
+			},
+			{
+				Config: testHarborScanAllSchedule("custom", "0 30 2 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_scan_all_schedule.schedule", "schedule", "custom"),
+					resource.TestCheckResourceAttr("harbor_scan_all_schedule.schedule", "cron", "0 30 2 * * *"),
+				),
+			},
+			{
+				Config:      testHarborScanAllSchedule("daily", "0 30 2 * * *"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("cron can only be set when schedule is 'custom'"),
+			},
+		},
+	})
+}
+
+func testHarborScanAllSchedule(schedule string, cron string) string {
+	cronArgument := ""
+	if cron != "" {
+		cronArgument = fmt.Sprintf(`cron = "%s"`, cron)
+	}
+
+	return fmt.Sprintf(`
+resource "harbor_scan_all_schedule" "schedule" {
+	schedule = "%s"
+	%s
+}
+	`, schedule, cronArgument)
+}
+
+func TestScheduleCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "/system/scanAll/schedule",
+		Attributes: map[string]string{
+			"id":       "/system/scanAll/schedule",
+			"schedule": "custom",
+			"cron":     "0 30 2 * * *",
+		},
+	}
+
+	cases := []struct {
+		schedule string
+		cron     string
+		err      bool
+	}{
+		{schedule: "custom", cron: "0 30 2 * * *"},
+		{schedule: "custom", cron: "0 0 3 * * *"},
+		{schedule: "daily"},
+		{schedule: "daily", cron: "0 30 2 * * *", err: true},
+		{schedule: "weekly", cron: "0 0 3 * * *", err: true},
+	}
+
+	for _, c := range cases {
+		config := map[string]interface{}{"schedule": c.schedule}
+		if c.cron != "" {
+			config["cron"] = c.cron
+		}
+
+		_, err := resourceScanAllSchedule().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		if c.err && err == nil {
+			t.Errorf("expected schedule %s with cron %q to be rejected", c.schedule, c.cron)
+		}
+		if !c.err && err != nil {
+			t.Errorf("unexpected error for schedule %s with cron %q: %s", c.schedule, c.cron, err)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func resourceScanner() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceScannerCreate,
+		Read:   resourceScannerRead,
+		Update: resourceScannerUpdate,
+		Delete: resourceScannerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Display name of the scanner.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the scanner.",
+				Optional:    true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "Base URL of the scanner adapter.",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Description:  "How Harbor authenticates to the scanner adapter, one of 'Basic', 'Bearer' or 'X-ScannerAdapter-API-Key'. If not set, no authentication is used.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Bearer", "X-ScannerAdapter-API-Key"}, false),
+			},
+			"access_credential": {
+				Type:        schema.TypeString,
+				Description: "Credential used to authenticate to the scanner adapter, e.g. 'username:password' for Basic authentication.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"skip_cert_verify": {
+				Type:        schema.TypeBool,
+				Description: "If true, skips tls certificate verification of the scanner adapter.",
+				Optional:    true,
+				Default:     false,
+			},
+			"use_internal_addr": {
+				Type:        schema.TypeBool,
+				Description: "If true, the scanner adapter pulls artifacts from the internal address of the registry.",
+				Optional:    true,
+				Default:     false,
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Description: "If true, the scanner is disabled.",
+				Optional:    true,
+				Default:     false,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "If true, the scanner is the system default. Harbor doesn't allow unsetting the default, another scanner must be made the default instead. Only one scanner may set it.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func mapDataToScanner(d *schema.ResourceData, scanner *harbor.ScannerRegistration) {
+	scanner.Name = d.Get("name").(string)
+	scanner.Description = d.Get("description").(string)
+	scanner.URL = d.Get("url").(string)
+	scanner.Auth = d.Get("auth_type").(string)
+	scanner.AccessCredential = d.Get("access_credential").(string)
+	scanner.SkipCertVerify = d.Get("skip_cert_verify").(bool)
+	scanner.UseInternalAddr = d.Get("use_internal_addr").(bool)
+	scanner.Disabled = d.Get("disabled").(bool)
+}
+
+func mapScannerToData(d *schema.ResourceData, scanner *harbor.ScannerRegistration) error {
+	err := d.Set("name", scanner.Name)
+	if err != nil {
+		return err
+	}
+	err = d.Set("description", scanner.Description)
+	if err != nil {
+		return err
+	}
+	err = d.Set("url", scanner.URL)
+	if err != nil {
+		return err
+	}
+	err = d.Set("auth_type", scanner.Auth)
+	if err != nil {
+		return err
+	}
+	err = d.Set("skip_cert_verify", scanner.SkipCertVerify)
+	if err != nil {
+		return err
+	}
+	err = d.Set("use_internal_addr", scanner.UseInternalAddr)
+	if err != nil {
+		return err
+	}
+	err = d.Set("disabled", scanner.Disabled)
+	if err != nil {
+		return err
+	}
+	err = d.Set("default", scanner.IsDefault)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceScannerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	scanner, err := client.GetScanner(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	return mapScannerToData(d, scanner)
+}
+
+func resourceScannerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	scanner := &harbor.ScannerRegistration{}
+	mapDataToScanner(d, scanner)
+
+	location, err := client.NewScanner(scanner)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(location)
+
+	if d.Get("default").(bool) {
+		err = client.SetDefaultScanner(d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceScannerRead(d, meta)
+}
+
+func resourceScannerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	scanner := &harbor.ScannerRegistration{}
+	mapDataToScanner(d, scanner)
+
+	err := client.UpdateScanner(d.Id(), scanner)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("default") && d.Get("default").(bool) {
+		err = client.SetDefaultScanner(d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceScannerRead(d, meta)
+}
+
+func resourceScannerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.DeleteScanner(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHarborScannerUpdate(t *testing.T) {
+	t.Parallel()
+
+	scannerName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_scanner"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborScannerBasic(scannerName, "http://scanner.example.com:8080"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_scanner.scanner"),
+					resource.TestCheckResourceAttr("harbor_scanner.scanner", "auth_type", "X-ScannerAdapter-API-Key"),
+					resource.TestCheckResourceAttr("harbor_scanner.scanner", "default", "false"),
+				),
+			},
+			{
+				Config: testHarborScannerBasic(scannerName, "http://scanner.example.com:8081"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_scanner.scanner"),
+					resource.TestCheckResourceAttr("harbor_scanner.scanner", "url", "http://scanner.example.com:8081"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHarborScannerProject(t *testing.T) {
+	t.Parallel()
+
+	scannerName := "terraform-" + acctest.RandString(10)
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckResourceDestroy("harbor_scanner"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborScannerProject(scannerName, projectName),
+				Check:  resource.TestCheckResourceAttrPair("harbor_project.project", "scanner_id", "harbor_scanner.scanner", "id"),
+			},
+		},
+	})
+}
+
+func testHarborScannerBasic(name string, url string) string {
+	return fmt.Sprintf(`
+resource "harbor_scanner" "scanner" {
+	name              = "%s"
+	url               = "%s"
+	auth_type         = "X-ScannerAdapter-API-Key"
+	access_credential = "secret"
+}
+	`, name, url)
+}
+
+func testHarborScannerProject(name string, projectName string) string {
+	return fmt.Sprintf(`
+resource "harbor_scanner" "scanner" {
+	name = "%s"
+	url  = "http://scanner.example.com:8080"
+}
+
+resource "harbor_project" "project" {
+	name       = "%s"
+	scanner_id = harbor_scanner.scanner.id
+}
+	`, name, projectName)
+}
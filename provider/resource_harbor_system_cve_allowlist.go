@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func resourceSystemCVEAllowlist() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSystemCVEAllowlistUpdate,
+		Read:   resourceSystemCVEAllowlistRead,
+		Update: resourceSystemCVEAllowlistUpdate,
+		Delete: resourceSystemCVEAllowlistDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cve_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the CVEs that are ignored when preventing vulnerable images from running.",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Description:      "Sets the time at which the allowlist will expire in UTC. If this isn't set the allowlist will never expire.",
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+		},
+	}
+}
+
+// suppressEquivalentRFC3339Time ignores differences between times which are
+// the same instant, as Harbor only stores a timestamp and it's read back in UTC.
+func suppressEquivalentRFC3339Time(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func mapDataToCVEAllowlist(d *schema.ResourceData, allowlist *harbor.CVEAllowlist) error {
+	cveIDs := d.Get("cve_ids").(*schema.Set).List()
+	allowlist.Items = make([]*harbor.CVEAllowlistItem, len(cveIDs))
+	for i, cveID := range cveIDs {
+		allowlist.Items[i] = &harbor.CVEAllowlistItem{CVEID: cveID.(string)}
+	}
+
+	expiresAt := d.Get("expires_at").(string)
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return err
+		}
+		expires := t.Unix()
+		allowlist.ExpiresAt = &expires
+	}
+	return nil
+}
+
+func mapCVEAllowlistToData(d *schema.ResourceData, allowlist *harbor.CVEAllowlist) error {
+	cveIDs := make([]string, len(allowlist.Items))
+	for i, item := range allowlist.Items {
+		cveIDs[i] = item.CVEID
+	}
+	err := d.Set("cve_ids", cveIDs)
+	if err != nil {
+		return err
+	}
+
+	expiresAt := ""
+	if allowlist.ExpiresAt != nil {
+		expiresAt = time.Unix(*allowlist.ExpiresAt, 0).UTC().Format(time.RFC3339)
+	}
+	err = d.Set("expires_at", expiresAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceSystemCVEAllowlistRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	allowlist, err := client.GetSystemCVEAllowlist()
+	if err != nil {
+		return err
+	}
+
+	return mapCVEAllowlistToData(d, allowlist)
+}
+
+func resourceSystemCVEAllowlistUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	allowlist := &harbor.CVEAllowlist{}
+	err := mapDataToCVEAllowlist(d, allowlist)
+	if err != nil {
+		return err
+	}
+
+	err = client.UpdateSystemCVEAllowlist(allowlist)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("/system/CVEAllowlist")
+	return resourceSystemCVEAllowlistRead(d, meta)
+}
+
+func resourceSystemCVEAllowlistDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	// the system allowlist always exists, so destroying this resource empties it
+	err := client.UpdateSystemCVEAllowlist(&harbor.CVEAllowlist{Items: []*harbor.CVEAllowlistItem{}})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
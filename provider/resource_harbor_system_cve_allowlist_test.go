@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+func TestAccHarborSystemCVEAllowlistUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckSystemCVEAllowlistEmpty,
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborSystemCVEAllowlist(`"CVE-2021-44228"`, "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceExists("harbor_system_cve_allowlist.allowlist"),
+					resource.TestCheckResourceAttr("harbor_system_cve_allowlist.allowlist", "cve_ids.#", "1"),
+					resource.TestCheckResourceAttr("harbor_system_cve_allowlist.allowlist", "expires_at", "2030-01-01T00:00:00Z"),
+				),
+			},
+			{
+				Config: testHarborSystemCVEAllowlist(`"CVE-2021-44228", "CVE-2021-45046"`, "2031-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_system_cve_allowlist.allowlist", "cve_ids.#", "2"),
+					resource.TestCheckResourceAttr("harbor_system_cve_allowlist.allowlist", "expires_at", "2031-01-01T00:00:00Z"),
+				),
+			},
+			{
+				// the same instant with a UTC offset doesn't cause a diff
+				Config:   testHarborSystemCVEAllowlist(`"CVE-2021-44228", "CVE-2021-45046"`, "2031-01-01T01:00:00+01:00"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccHarborSystemCVEAllowlistOutOfBandEdit(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testCheckSystemCVEAllowlistEmpty,
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborSystemCVEAllowlist(`"CVE-2021-44228"`, "2030-01-01T00:00:00Z"),
+				Check:  resource.TestCheckResourceAttr("harbor_system_cve_allowlist.allowlist", "cve_ids.#", "1"),
+			},
+			{
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*harbor.Client)
+
+					err := client.UpdateSystemCVEAllowlist(&harbor.CVEAllowlist{Items: []*harbor.CVEAllowlistItem{}})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testHarborSystemCVEAllowlist(`"CVE-2021-44228"`, "2030-01-01T00:00:00Z"),
+				Check:  resource.TestCheckResourceAttr("harbor_system_cve_allowlist.allowlist", "cve_ids.#", "1"),
+			},
+		},
+	})
+}
+
+func testCheckSystemCVEAllowlistEmpty(s *terraform.State) error {
+	client := testAccProvider.Meta().(*harbor.Client)
+
+	allowlist, err := client.GetSystemCVEAllowlist()
+	if err != nil {
+		return err
+	}
+	if len(allowlist.Items) > 0 {
+		return fmt.Errorf("system CVE allowlist still contains %d items", len(allowlist.Items))
+	}
+
+	return nil
+}
+
+func testHarborSystemCVEAllowlist(cveIDs string, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "harbor_system_cve_allowlist" "allowlist" {
+	cve_ids    = [%s]
+	expires_at = "%s"
+}
+	`, cveIDs, expiresAt)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var tagIDRegexp = regexp.MustCompile(`^/projects/([^/]+)/repositories/([^/]+)/tags/([^/]+)$`)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagCreate,
+		Read:   resourceTagRead,
+		Update: resourceTagUpdate,
+		Delete: resourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTagImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the project the artifact belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the repository the artifact belongs to, without the project prefix.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Name of the tag, e.g. 'prod'.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w][\w.-]{0,127}$`), "validation error: name should be a valid tag of at most 128 characters"),
+			},
+			"digest": {
+				Type:         schema.TypeString,
+				Description:  "Digest of the artifact to tag. Changing it moves the tag to the new artifact.",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]+:[a-f0-9]+$`), "validation error: digest should be of the form 'sha256:${HEX}'"),
+			},
+			"move_if_exists": {
+				Type:        schema.TypeBool,
+				Description: "When true, a tag with the same name on another artifact is moved to this artifact, rather than failing the apply.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// tagID returns the ID of a tag. Tags don't have an API path of their own
+// which is independent of the artifact they point at, so the ID only
+// identifies the tag within its repository.
+func tagID(projectName string, repoName string, tagName string) string {
+	return fmt.Sprintf("%s/tags/%s", harbor.RepositoryID(projectName, repoName), tagName)
+}
+
+func resourceTagImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	matches := tagIDRegexp.FindStringSubmatch(d.Id())
+	if matches == nil {
+		return nil, fmt.Errorf("invalid tag id %s, expected the form '/projects/${PROJECT_NAME}/repositories/${REPOSITORY_NAME}/tags/${TAG_NAME}'", d.Id())
+	}
+
+	repoName, err := unescapeRepositoryName(matches[2])
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.Set("project_name", matches[1])
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("repository_name", repoName)
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("name", matches[3])
+	if err != nil {
+		return nil, err
+	}
+	err = d.Set("move_if_exists", false)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(tagID(matches[1], repoName, matches[3]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	// resolving the tag as a reference gives the artifact it currently points at
+	artifact, err := client.GetArtifact(d.Get("project_name").(string), d.Get("repository_name").(string), d.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	return d.Set("digest", artifact.Digest)
+}
+
+// moveTag removes an existing tag from the artifact it points at, so that it
+// can be added to another artifact. Only tags pointing at a different
+// artifact are moved, and only when move_if_exists is true.
+func moveTag(d *schema.ResourceData, client *harbor.Client) error {
+	projectName := d.Get("project_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+	tagName := d.Get("name").(string)
+	digest := d.Get("digest").(string)
+
+	current, err := client.GetArtifact(projectName, repositoryName, tagName)
+	if harbor.ErrorIs404(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current.Digest == digest {
+		return nil
+	}
+
+	if !d.Get("move_if_exists").(bool) {
+		return fmt.Errorf("tag %s already points at artifact %s, set move_if_exists to move it", tagName, current.Digest)
+	}
+	return client.DeleteArtifactTag(projectName, repositoryName, current.Digest, tagName)
+}
+
+func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+	projectName := d.Get("project_name").(string)
+	repositoryName := d.Get("repository_name").(string)
+	tagName := d.Get("name").(string)
+	digest := d.Get("digest").(string)
+
+	// the artifact is checked before moving the tag, so that a wrong digest
+	// doesn't leave the tag pointing at nothing
+	artifact, err := client.GetArtifact(projectName, repositoryName, digest)
+	if err != nil {
+		return err
+	}
+
+	err = moveTag(d, client)
+	if err != nil {
+		return err
+	}
+
+	tagged := false
+	for _, tag := range artifact.Tags {
+		if tag.Name == tagName {
+			tagged = true
+		}
+	}
+	if !tagged {
+		err = client.AddArtifactTag(projectName, repositoryName, digest, tagName)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(tagID(projectName, repositoryName, tagName))
+	return resourceTagRead(d, meta)
+}
+
+func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	if d.HasChange("digest") {
+		oldDigest, newDigest := d.GetChange("digest")
+		projectName := d.Get("project_name").(string)
+		repositoryName := d.Get("repository_name").(string)
+		tagName := d.Get("name").(string)
+
+		_, err := client.GetArtifact(projectName, repositoryName, newDigest.(string))
+		if err != nil {
+			return err
+		}
+
+		err = client.DeleteArtifactTag(projectName, repositoryName, oldDigest.(string), tagName)
+		if err != nil && !harbor.ErrorIs404(err) {
+			return err
+		}
+
+		err = moveTag(d, client)
+		if err != nil {
+			return err
+		}
+
+		err = client.AddArtifactTag(projectName, repositoryName, newDigest.(string), tagName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceTagRead(d, meta)
+}
+
+func resourceTagDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*harbor.Client)
+
+	err := client.DeleteArtifactTag(d.Get("project_name").(string), d.Get("repository_name").(string), d.Get("digest").(string), d.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(err, d)
+	}
+
+	d.SetId("")
+	return nil
+}
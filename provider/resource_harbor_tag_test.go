@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+const (
+	testTagOldDigest     = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	testTagNewDigest     = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	testTagMissingDigest = "sha256:3333333333333333333333333333333333333333333333333333333333333333"
+)
+
+// testTagServer is a fake Harbor API serving the artifacts of the repository
+// example/app, which records the requests it receives.
+type testTagServer struct {
+	tags     map[string][]string
+	requests []string
+}
+
+func newTestTagServer(t *testing.T, tags map[string][]string) (*testTagServer, *harbor.Client) {
+	server := &testTagServer{tags: tags}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	return server, harbor.NewClient(httpServer.URL, "admin", "password", false, "")
+}
+
+func (server *testTagServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/v2.0/projects/example/repositories/app/artifacts/")
+	server.requests = append(server.requests, r.Method+" "+path)
+	parts := strings.Split(path, "/")
+
+	switch {
+	case r.Method == http.MethodGet && len(parts) == 1:
+		for digest, tags := range server.tags {
+			if digest != parts[0] && !testTagContains(tags, parts[0]) {
+				continue
+			}
+			artifact := &harbor.Artifact{Digest: digest}
+			for _, tag := range tags {
+				artifact.Tags = append(artifact.Tags, &harbor.Tag{Name: tag})
+			}
+			_ = json.NewEncoder(w).Encode(artifact)
+			return
+		}
+	case r.Method == http.MethodDelete && len(parts) == 3 && parts[1] == "tags":
+		tags, ok := server.tags[parts[0]]
+		if ok && testTagContains(tags, parts[2]) {
+			remaining := []string{}
+			for _, tag := range tags {
+				if tag != parts[2] {
+					remaining = append(remaining, tag)
+				}
+			}
+			server.tags[parts[0]] = remaining
+			return
+		}
+	case r.Method == http.MethodPost && len(parts) == 2 && parts[1] == "tags":
+		var tag harbor.ArtifactTagReq
+		if _, ok := server.tags[parts[0]]; ok && json.NewDecoder(r.Body).Decode(&tag) == nil {
+			server.tags[parts[0]] = append(server.tags[parts[0]], tag.Name)
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func testTagContains(tags []string, name string) bool {
+	for _, tag := range tags {
+		if tag == name {
+			return true
+		}
+	}
+	return false
+}
+
+func testTagResourceData(t *testing.T, state map[string]string, config map[string]interface{}) *schema.ResourceData {
+	var instanceState *terraform.InstanceState
+	if state != nil {
+		instanceState = &terraform.InstanceState{ID: tagID("example", "app", "prod"), Attributes: state}
+	}
+
+	schemaMap := schema.InternalMap(resourceTag().Schema)
+	diff, err := schemaMap.Diff(context.Background(), instanceState, terraform.NewResourceConfigRaw(config), nil, nil, true)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	d, err := schemaMap.Data(instanceState, diff)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return d
+}
+
+func testTagConfig(digest string) map[string]interface{} {
+	return map[string]interface{}{
+		"project_name":    "example",
+		"repository_name": "app",
+		"name":            "prod",
+		"digest":          digest,
+		"move_if_exists":  true,
+	}
+}
+
+func TestResourceTagCreateMovesTag(t *testing.T) {
+	server, client := newTestTagServer(t, map[string][]string{
+		testTagOldDigest: {"prod"},
+		testTagNewDigest: {"rc"},
+	})
+
+	d := testTagResourceData(t, nil, testTagConfig(testTagNewDigest))
+	err := resourceTagCreate(d, client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"GET " + testTagNewDigest,
+		"GET prod",
+		"DELETE " + testTagOldDigest + "/tags/prod",
+		"POST " + testTagNewDigest + "/tags",
+		"GET prod",
+	}
+	if !reflect.DeepEqual(server.requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, server.requests)
+	}
+	if d.Get("digest").(string) != testTagNewDigest || !testTagContains(server.tags[testTagNewDigest], "prod") {
+		t.Fatalf("expected the tag to point at %s, got %v", testTagNewDigest, server.tags)
+	}
+}
+
+func TestResourceTagCreateMissingArtifact(t *testing.T) {
+	server, client := newTestTagServer(t, map[string][]string{
+		testTagOldDigest: {"prod"},
+	})
+
+	d := testTagResourceData(t, nil, testTagConfig(testTagMissingDigest))
+	err := resourceTagCreate(d, client)
+	if !harbor.ErrorIs404(err) {
+		t.Fatalf("expected a 404 error, got %v", err)
+	}
+
+	expected := []string{"GET " + testTagMissingDigest}
+	if !reflect.DeepEqual(server.requests, expected) {
+		t.Fatalf("expected requests %v, got %v", expected, server.requests)
+	}
+	if !testTagContains(server.tags[testTagOldDigest], "prod") {
+		t.Fatalf("expected the tag to be left on %s, got %v", testTagOldDigest, server.tags)
+	}
+}
+
+func TestResourceTagUpdate(t *testing.T) {
+	state := map[string]string{
+		"id":              tagID("example", "app", "prod"),
+		"project_name":    "example",
+		"repository_name": "app",
+		"name":            "prod",
+		"digest":          testTagOldDigest,
+		"move_if_exists":  "true",
+	}
+
+	t.Run("moves the tag", func(t *testing.T) {
+		server, client := newTestTagServer(t, map[string][]string{
+			testTagOldDigest: {"prod"},
+			testTagNewDigest: {},
+		})
+
+		d := testTagResourceData(t, state, testTagConfig(testTagNewDigest))
+		err := resourceTagUpdate(d, client)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		expected := []string{
+			"GET " + testTagNewDigest,
+			"DELETE " + testTagOldDigest + "/tags/prod",
+			"GET prod",
+			"POST " + testTagNewDigest + "/tags",
+			"GET prod",
+		}
+		if !reflect.DeepEqual(server.requests, expected) {
+			t.Fatalf("expected requests %v, got %v", expected, server.requests)
+		}
+		if d.Get("digest").(string) != testTagNewDigest {
+			t.Fatalf("expected the tag to point at %s, got %s", testTagNewDigest, d.Get("digest"))
+		}
+	})
+
+	t.Run("missing artifact", func(t *testing.T) {
+		server, client := newTestTagServer(t, map[string][]string{
+			testTagOldDigest: {"prod"},
+		})
+
+		d := testTagResourceData(t, state, testTagConfig(testTagMissingDigest))
+		err := resourceTagUpdate(d, client)
+		if !harbor.ErrorIs404(err) {
+			t.Fatalf("expected a 404 error, got %v", err)
+		}
+
+		expected := []string{"GET " + testTagMissingDigest}
+		if !reflect.DeepEqual(server.requests, expected) {
+			t.Fatalf("expected requests %v, got %v", expected, server.requests)
+		}
+		if !testTagContains(server.tags[testTagOldDigest], "prod") {
+			t.Fatalf("expected the tag to be left on %s, got %v", testTagOldDigest, server.tags)
+		}
+	})
+}
+
+func TestAccHarborTagMissingArtifact(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborTag(projectName, "missing", "sha256:0000000000000000000000000000000000000000000000000000000000000000"),
+				ExpectError: regexp.MustCompile("404 Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccHarborTagInvalidDigest(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testHarborTag(projectName, "app", "latest"),
+				ExpectError: regexp.MustCompile("digest should be of the form"),
+			},
+		},
+	})
+}
+
+func TestAccHarborTagBasic(t *testing.T) {
+	t.Parallel()
+
+	projectName := "terraform-" + acctest.RandString(10)
+	var digest string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			digest = testAccPushImage(t, projectName, "app", "latest")
+		},
+		CheckDestroy: testCheckResourceDestroy("harbor_tag"),
+		Steps: []resource.TestStep{
+			{
+				Config: testHarborTagPushed(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("harbor_tag.tag", "id", tagID(projectName, "app", "prod")),
+					resource.TestCheckResourceAttrPtr("harbor_tag.tag", "digest", &digest),
+				),
+			},
+			{
+				ResourceName:      "harbor_tag.tag",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testHarborTag(projectName string, repositoryName string, digest string) string {
+	return fmt.Sprintf(`
+resource "harbor_project" "project" {
+	name = "%s"
+}
+
+resource "harbor_tag" "tag" {
+	project_name    = harbor_project.project.name
+	repository_name = "%s"
+	name            = "prod"
+	digest          = "%s"
+}
+	`, projectName, repositoryName, digest)
+}
+
+// testHarborTagPushed tags the image pushed by testAccPushImage, whose digest
+// is looked up as it isn't known when the test steps are built.
+func testHarborTagPushed(projectName string) string {
+	return fmt.Sprintf(`
+data "harbor_artifacts" "artifacts" {
+	project_name    = "%s"
+	repository_name = "app"
+	tag             = "latest"
+}
+
+resource "harbor_tag" "tag" {
+	project_name    = data.harbor_artifacts.artifacts.project_name
+	repository_name = "app"
+	name            = "prod"
+	digest          = data.harbor_artifacts.artifacts.artifacts[0].digest
+}
+	`, projectName)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/liatrio/terraform-provider-harbor/harbor"
+)
+
+var scheduleTypes = map[string]string{
+	"none":   harbor.ScheduleTypeNone,
+	"hourly": harbor.ScheduleTypeHourly,
+	"daily":  harbor.ScheduleTypeDaily,
+	"weekly": harbor.ScheduleTypeWeekly,
+	"custom": harbor.ScheduleTypeCustom,
+}
+
+func scheduleTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "How often the job runs, one of 'none', 'hourly', 'daily', 'weekly' or 'custom'.",
+		Required:     true,
+		ValidateFunc: validation.StringInSlice([]string{"none", "hourly", "daily", "weekly", "custom"}, false),
+	}
+}
+
+func scheduleCronSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Cron expression with six fields, starting with seconds, used when schedule is 'custom'.",
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\S+( \S+){5}$`), "validation error: cron should have six fields, e.g. '0 0 2 * * *'"),
+	}
+}
+
+// scheduleCustomizeDiff rejects a cron expression in the configuration of a
+// schedule that isn't custom, since Harbor would silently ignore it.
+func scheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("schedule") || !d.NewValueKnown("cron") {
+		return nil
+	}
+
+	schedule := d.Get("schedule").(string)
+	if cron := d.Get("cron").(string); cron != "" && schedule != "custom" {
+		return fmt.Errorf("cron can only be set when schedule is 'custom', but schedule is '%s'", schedule)
+	}
+	return nil
+}
+
+func mapDataToSchedule(d *schema.ResourceData, schedule *harbor.Schedule) error {
+	scheduleType := scheduleTypes[d.Get("schedule").(string)]
+	schedule.Schedule = &harbor.ScheduleObj{Type: scheduleType}
+
+	if scheduleType == harbor.ScheduleTypeCustom {
+		cron := d.Get("cron").(string)
+		if cron == "" {
+			return fmt.Errorf("cron must be set when schedule is 'custom'")
+		}
+		schedule.Schedule.Cron = cron
+	}
+	return nil
+}
+
+func mapScheduleToData(d *schema.ResourceData, schedule *harbor.Schedule) error {
+	scheduleObj := &harbor.ScheduleObj{Type: harbor.ScheduleTypeNone}
+	if schedule != nil && schedule.Schedule != nil {
+		scheduleObj = schedule.Schedule
+	}
+
+	err := d.Set("schedule", strings.ToLower(scheduleObj.Type))
+	if err != nil {
+		return err
+	}
+	// Harbor reports a cron expression for every schedule type, but only the
+	// one of custom schedules can be configured
+	cron := ""
+	if scheduleObj.Type == harbor.ScheduleTypeCustom {
+		cron = scheduleObj.Cron
+	}
+	err = d.Set("cron", cron)
+	if err != nil {
+		return err
+	}
+	return nil
+}
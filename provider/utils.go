@@ -2,6 +2,7 @@ package provider
 
 import (
 	"log"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/liatrio/terraform-provider-harbor/harbor"
@@ -17,3 +18,14 @@ func handleNotFoundError(err error, data *schema.ResourceData) error {
 
 	return err
 }
+
+// unescapeRepositoryName reverses the double encoding Harbor requires for
+// repository names in API paths.
+func unescapeRepositoryName(name string) (string, error) {
+	name, err := url.PathUnescape(name)
+	if err != nil {
+		return "", err
+	}
+
+	return url.PathUnescape(name)
+}